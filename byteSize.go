@@ -0,0 +1,131 @@
+package confiq
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const byteSizeUnit = "bytes"
+
+var (
+	errByteSizeCannotBeNil  = errors.New("byte size cannot be nil")
+	errCannotParseByteSize  = errors.New("cannot parse byte size")
+	errInvalidByteSizeUnit  = errors.New("invalid byte size unit")
+	errByteSizeOutOfRange   = errors.New("byte size out of range")
+	errNegativeByteSize     = errors.New("byte size cannot be negative")
+	byteSizePattern         = regexp.MustCompile(`^(\d+(?:\.\d+)?|\.\d+)\s*([a-zA-Z]*)$`)
+	byteSizeUnitMultipliers = map[string]float64{
+		"":    1,
+		"b":   1,
+		"k":   1e3,
+		"kb":  1e3,
+		"m":   1e6,
+		"mb":  1e6,
+		"g":   1e9,
+		"gb":  1e9,
+		"t":   1e12,
+		"tb":  1e12,
+		"p":   1e15,
+		"pb":  1e15,
+		"e":   1e18,
+		"eb":  1e18,
+		"ki":  1 << 10,
+		"kib": 1 << 10,
+		"mi":  1 << 20,
+		"mib": 1 << 20,
+		"gi":  1 << 30,
+		"gib": 1 << 30,
+		"ti":  1 << 40,
+		"tib": 1 << 40,
+		"pi":  1 << 50,
+		"pib": 1 << 50,
+		"ei":  1 << 60,
+		"eib": 1 << 60,
+	}
+)
+
+// ByteSize is a number of bytes, which can be decoded from human-friendly sizes such as "10MB" or "512KiB".
+// Decimal units (KB, MB, GB...) are multiples of 1000, binary units (KiB, MiB, GiB...) are multiples of 1024.
+type ByteSize uint64
+
+// UnmarshalText parses a human-friendly size into the ByteSize.
+func (b *ByteSize) UnmarshalText(text []byte) error {
+	size, err := parseByteSize(string(text))
+	if err != nil {
+		return err
+	}
+
+	*b = ByteSize(size)
+
+	return nil
+}
+
+func decodeByteSize(targetValue reflect.Value, sourceValue any, _ fieldOptions) error {
+	if sourceValue == nil {
+		return errByteSizeCannotBeNil
+	}
+
+	size, err := parseByteSize(formatNumber(sourceValue))
+	if err != nil {
+		return err
+	}
+
+	switch targetValue.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if size > math.MaxInt64 || targetValue.OverflowInt(int64(size)) {
+			return fmt.Errorf("%w: %d", errByteSizeOutOfRange, size)
+		}
+
+		targetValue.SetInt(int64(size))
+	default:
+		if targetValue.OverflowUint(size) {
+			return fmt.Errorf("%w: %d", errByteSizeOutOfRange, size)
+		}
+
+		targetValue.SetUint(size)
+	}
+
+	return nil
+}
+
+func parseByteSize(value string) (uint64, error) {
+	value = strings.TrimSpace(value)
+
+	if strings.HasPrefix(value, "-") {
+		return 0, fmt.Errorf("%w: %s", errNegativeByteSize, value)
+	}
+
+	matches := byteSizePattern.FindStringSubmatch(value)
+	if matches == nil {
+		return 0, fmt.Errorf("%w: %s", errCannotParseByteSize, value)
+	}
+
+	multiplier, ok := byteSizeUnitMultipliers[strings.ToLower(matches[2])]
+	if !ok {
+		return 0, fmt.Errorf("%w: %s", errInvalidByteSizeUnit, matches[2])
+	}
+
+	// integers without a unit are parsed directly, so that they don't lose precision as floats
+	if multiplier == 1 {
+		if size, err := strconv.ParseUint(matches[1], 10, 64); err == nil {
+			return size, nil
+		}
+	}
+
+	number, err := strconv.ParseFloat(matches[1], 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", errCannotParseByteSize, err)
+	}
+
+	size := math.Round(number * multiplier)
+	if size >= math.MaxUint64 {
+		return 0, fmt.Errorf("%w: %s", errByteSizeOutOfRange, value)
+	}
+
+	return uint64(size), nil
+}
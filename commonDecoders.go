@@ -4,26 +4,78 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io/fs"
+	"log/slog"
+	"math"
+	"math/big"
 	"net"
+	"net/mail"
+	"net/netip"
 	"net/url"
 	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
 	"time"
 )
 
 var (
-	errIPCannotBeNil             = errors.New("IP address cannot be nil")
-	errCannotParseIP             = errors.New("cannot parse IP address")
-	errURLCannotBeNil            = errors.New("URL cannot be nil")
-	errCannotParseURL            = errors.New("cannot parse URL")
-	errDurationCannotBeNil       = errors.New("duration cannot be nil")
-	errCannotParseDuration       = errors.New("cannot parse duration")
-	errTimeCannotBeNil           = errors.New("time cannot be nil")
-	errCannotParseTime           = errors.New("cannot parse time")
-	errCannotParseNonStringTime  = errors.New("cannot parse time from non-string type")
-	errJSONRawMessageCannotBeNil = errors.New("JSON raw message cannot be nil")
-	errCannotParseJSONRawMessage = errors.New("cannot marshal source value to JSON")
+	errIPCannotBeNil              = errors.New("IP address cannot be nil")
+	errCannotParseIP              = errors.New("cannot parse IP address")
+	errURLCannotBeNil             = errors.New("URL cannot be nil")
+	errCannotParseURL             = errors.New("cannot parse URL")
+	errDurationCannotBeNil        = errors.New("duration cannot be nil")
+	errCannotParseDuration        = errors.New("cannot parse duration")
+	errCannotParseISODuration     = errors.New("cannot parse ISO 8601 duration")
+	errUnsupportedISODurationUnit = errors.New("ISO 8601 durations with years or months are not supported")
+	errTimeCannotBeNil            = errors.New("time cannot be nil")
+	errCannotParseTime            = errors.New("cannot parse time")
+	errCannotParseNonStringTime   = errors.New("cannot parse time from non-string type")
+	errInvalidTimeUnit            = errors.New("invalid time unit")
+	errLocationCannotBeNil        = errors.New("location cannot be nil")
+	errCannotParseLocation        = errors.New("cannot parse location")
+	errAddrCannotBeNil            = errors.New("address cannot be nil")
+	errCannotParseAddr            = errors.New("cannot parse address")
+	errAddrPortCannotBeNil        = errors.New("address and port cannot be nil")
+	errCannotParseAddrPort        = errors.New("cannot parse address and port")
+	errPrefixCannotBeNil          = errors.New("prefix cannot be nil")
+	errCannotParsePrefix          = errors.New("cannot parse prefix")
+	errIPNetCannotBeNil           = errors.New("IP network cannot be nil")
+	errCannotParseIPNet           = errors.New("cannot parse IP network")
+	errHardwareAddrCannotBeNil    = errors.New("hardware address cannot be nil")
+	errCannotParseHardwareAddr    = errors.New("cannot parse hardware address")
+	errRegexpCannotBeNil          = errors.New("regular expression cannot be nil")
+	errCannotParseRegexp          = errors.New("cannot parse regular expression")
+	errBigIntCannotBeNil          = errors.New("big integer cannot be nil")
+	errCannotParseBigInt          = errors.New("cannot parse big integer")
+	errBigFloatCannotBeNil        = errors.New("big float cannot be nil")
+	errCannotParseBigFloat        = errors.New("cannot parse big float")
+	errTemplateCannotBeNil        = errors.New("template cannot be nil")
+	errCannotParseTemplate        = errors.New("cannot parse template")
+	errFileModeCannotBeNil        = errors.New("file mode cannot be nil")
+	errCannotParseFileMode        = errors.New("cannot parse file mode")
+	errLogLevelCannotBeNil        = errors.New("log level cannot be nil")
+	errCannotParseLogLevel        = errors.New("cannot parse log level")
+	errMailAddressCannotBeNil     = errors.New("mail address cannot be nil")
+	errCannotParseMailAddress     = errors.New("cannot parse mail address")
+	errJSONRawMessageCannotBeNil  = errors.New("JSON raw message cannot be nil")
+	errCannotParseJSONRawMessage  = errors.New("cannot marshal source value to JSON")
 )
 
+var (
+	dayWeekDurationPattern = regexp.MustCompile(`(\d+(\.\d*)?|\.\d+)[dw]`)
+	isoDurationPattern     = regexp.MustCompile(`^([-+])?P(?:(\d+(?:[.,]\d+)?)Y)?(?:(\d+(?:[.,]\d+)?)M)?(?:(\d+(?:[.,]\d+)?)W)?(?:(\d+(?:[.,]\d+)?)D)?(?:T(?:(\d+(?:[.,]\d+)?)H)?(?:(\d+(?:[.,]\d+)?)M)?(?:(\d+(?:[.,]\d+)?)S)?)?$`)
+)
+
+var defaultTimeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	time.DateTime,
+	time.DateOnly,
+	time.TimeOnly,
+}
+
 type typeDefinition struct {
 	kind     reflect.Kind
 	pkgPath  string
@@ -31,11 +83,26 @@ type typeDefinition struct {
 }
 
 var commonDecoders = map[typeDefinition]decoderFunc{
-	{reflect.Int64, "time", "Duration"}:            decodeDuration,
-	{reflect.Slice, "net", "IP"}:                   decodeIP,
-	{reflect.Slice, "encoding/json", "RawMessage"}: decodeJSONRawMessage,
-	{reflect.Struct, "time", "Time"}:               decodeTime,
-	{reflect.Struct, "net/url", "URL"}:             decodeURL,
+	{reflect.Int64, "time", "Duration"}:                         decodeDuration,
+	{reflect.Slice, "net", "IP"}:                                decodeIP,
+	{reflect.Slice, "encoding/json", "RawMessage"}:              decodeJSONRawMessage,
+	{reflect.Slice, "encoding/json/jsontext", "Value"}:          decodeJSONRawMessage,
+	{reflect.Struct, "time", "Time"}:                            decodeTime,
+	{reflect.Struct, "time", "Location"}:                        decodeLocation,
+	{reflect.Struct, "net/url", "URL"}:                          decodeURL,
+	{reflect.Uint64, "github.com/greencoda/confiq", "ByteSize"}: decodeByteSize,
+	{reflect.Struct, "net/netip", "Addr"}:                       decodeAddr,
+	{reflect.Struct, "net/netip", "AddrPort"}:                   decodeAddrPort,
+	{reflect.Struct, "net/netip", "Prefix"}:                     decodePrefix,
+	{reflect.Struct, "net", "IPNet"}:                            decodeIPNet,
+	{reflect.Slice, "net", "HardwareAddr"}:                      decodeHardwareAddr,
+	{reflect.Struct, "regexp", "Regexp"}:                        decodeRegexp,
+	{reflect.Struct, "math/big", "Int"}:                         decodeBigInt,
+	{reflect.Struct, "math/big", "Float"}:                       decodeBigFloat,
+	{reflect.Struct, "text/template", "Template"}:               decodeTemplate,
+	{reflect.Uint32, "io/fs", "FileMode"}:                       decodeFileMode,
+	{reflect.Int, "log/slog", "Level"}:                          decodeLogLevel,
+	{reflect.Struct, "net/mail", "Address"}:                     decodeMailAddress,
 }
 
 func getCommonDecoder(targetValType reflect.Type) decoderFunc {
@@ -50,12 +117,23 @@ func getCommonDecoder(targetValType reflect.Type) decoderFunc {
 	return nil
 }
 
-func decodeDuration(targetValue reflect.Value, sourceValue any) error {
+func decodeDuration(targetValue reflect.Value, sourceValue any, fieldOpts fieldOptions) error {
 	if sourceValue == nil {
 		return errDurationCannotBeNil
 	}
 
-	parsedDuration, err := time.ParseDuration(castToString(sourceValue))
+	var (
+		parsedDuration time.Duration
+		err            error
+	)
+
+	switch sV := sourceValue.(type) {
+	case time.Duration:
+		parsedDuration = sV
+	default:
+		parsedDuration, err = parseDuration(castToString(sourceValue), fieldOpts)
+	}
+
 	if err != nil {
 		return fmt.Errorf("%w: %w", errCannotParseDuration, err)
 	}
@@ -65,7 +143,101 @@ func decodeDuration(targetValue reflect.Value, sourceValue any) error {
 	return nil
 }
 
-func decodeIP(targetValue reflect.Value, sourceValue any) error {
+// parseDuration parses Go durations extended with days and weeks, ISO 8601 durations and bare numbers in the unit of the field.
+func parseDuration(value string, fieldOpts fieldOptions) (time.Duration, error) {
+	value = strings.TrimSpace(value)
+
+	if isoDurationPattern.MatchString(value) {
+		return parseISODuration(value)
+	}
+
+	if _, err := strconv.ParseFloat(value, 64); err == nil {
+		unit := fieldOpts.durationUnit
+
+		if fieldOpts.unit != "" {
+			if unit, err = parseTimeUnit(fieldOpts.unit); err != nil {
+				return 0, err
+			}
+		}
+
+		nanoseconds, err := scaleNumber(value, unit)
+		if err != nil {
+			return 0, err
+		}
+
+		return time.Duration(nanoseconds), nil
+	}
+
+	// days and weeks are converted to hours, as they aren't supported by time.ParseDuration
+	value = dayWeekDurationPattern.ReplaceAllStringFunc(value, func(match string) string {
+		hours := float64(24)
+		if strings.HasSuffix(match, "w") {
+			hours *= 7
+		}
+
+		number, _ := strconv.ParseFloat(match[:len(match)-1], 64)
+
+		return strconv.FormatFloat(number*hours, 'f', -1, 64) + "h"
+	})
+
+	return time.ParseDuration(value)
+}
+
+// parseISODuration parses ISO 8601 durations, such as PT5M or P1DT2H.
+// Years and months are rejected, as their length depends on the date they are applied to.
+func parseISODuration(value string) (time.Duration, error) {
+	matches := isoDurationPattern.FindStringSubmatch(value)
+	if strings.Join(matches[2:], "") == "" || strings.HasSuffix(value, "T") {
+		return 0, fmt.Errorf("%w: %s", errCannotParseISODuration, value)
+	}
+
+	if matches[2] != "" || matches[3] != "" {
+		return 0, fmt.Errorf("%w: %s", errUnsupportedISODurationUnit, value)
+	}
+
+	var (
+		units          = []time.Duration{0, 0, 0, 7 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+		parsedDuration time.Duration
+	)
+
+	for i := 4; i < len(matches); i++ {
+		if matches[i] == "" {
+			continue
+		}
+
+		number, err := strconv.ParseFloat(strings.Replace(matches[i], ",", ".", 1), 64)
+		if err != nil {
+			return 0, err
+		}
+
+		parsedDuration += time.Duration(number * float64(units[i-1]))
+	}
+
+	if matches[1] == "-" {
+		parsedDuration = -parsedDuration
+	}
+
+	return parsedDuration, nil
+}
+
+// parseTimeUnit parses units such as s or ms, as well as d for days and w for weeks.
+func parseTimeUnit(unit string) (time.Duration, error) {
+	switch unit {
+	case "d":
+		return 24 * time.Hour, nil
+	case "w":
+		return 7 * 24 * time.Hour, nil
+	}
+
+	parsedUnit, err := time.ParseDuration("1" + unit)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", errInvalidTimeUnit, err)
+	}
+
+	return parsedUnit, nil
+}
+
+func decodeIP(targetValue reflect.Value, sourceValue any, _ fieldOptions) error {
 	if sourceValue == nil {
 		return errIPCannotBeNil
 	}
@@ -80,7 +252,7 @@ func decodeIP(targetValue reflect.Value, sourceValue any) error {
 	return nil
 }
 
-func decodeJSONRawMessage(targetValue reflect.Value, sourceValue any) error {
+func decodeJSONRawMessage(targetValue reflect.Value, sourceValue any, _ fieldOptions) error {
 	if sourceValue == nil {
 		return errJSONRawMessageCannotBeNil
 	}
@@ -95,25 +267,32 @@ func decodeJSONRawMessage(targetValue reflect.Value, sourceValue any) error {
 	return nil
 }
 
-func decodeTime(targetValue reflect.Value, sourceValue any) error {
+func decodeTime(targetValue reflect.Value, sourceValue any, fieldOpts fieldOptions) error {
 	if sourceValue == nil {
 		return errTimeCannotBeNil
 	}
 
-	if timeValue, isTimeValue := sourceValue.(time.Time); isTimeValue {
-		targetValue.Set(reflect.ValueOf(timeValue))
-
-		return nil
-	}
+	var (
+		parsedTime time.Time
+		err        error
+	)
 
-	stringValue, isStringValue := sourceValue.(string)
-	if !isStringValue {
+	switch sV := sourceValue.(type) {
+	case time.Time:
+		parsedTime = sV
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, float32, float64:
+		parsedTime, err = parseUnixTime(sV, fieldOpts)
+	case string:
+		parsedTime, err = parseTime(sV, fieldOpts)
+	case fmt.Stringer:
+		// local dates and times, such as the ones of TOML are parsed from their string representation
+		parsedTime, err = parseTime(sV.String(), fieldOpts)
+	default:
 		return fmt.Errorf("%w: %T", errCannotParseNonStringTime, sourceValue)
 	}
 
-	parsedTime, err := time.Parse(time.RFC3339, stringValue)
 	if err != nil {
-		return fmt.Errorf("%w: %w", errCannotParseTime, err)
+		return err
 	}
 
 	targetValue.Set(reflect.ValueOf(parsedTime))
@@ -121,7 +300,84 @@ func decodeTime(targetValue reflect.Value, sourceValue any) error {
 	return nil
 }
 
-func decodeURL(targetValue reflect.Value, sourceValue any) error {
+func parseTime(value string, fieldOpts fieldOptions) (time.Time, error) {
+	var parseErrs []error
+
+	for _, layout := range fieldOpts.timeLayouts {
+		// the location is only used if the value doesn't contain time zone information
+		parsedTime, err := time.ParseInLocation(layout, value, fieldOpts.location)
+		if err == nil {
+			return parsedTime, nil
+		}
+
+		parseErrs = append(parseErrs, err)
+	}
+
+	return time.Time{}, fmt.Errorf("%w: %w", errCannotParseTime, errors.Join(parseErrs...))
+}
+
+// parseUnixTime parses numbers as unix timestamps, in seconds unless a different unit is set for the field.
+func parseUnixTime(value any, fieldOpts fieldOptions) (time.Time, error) {
+	unit := time.Second
+
+	if fieldOpts.unit != "" {
+		parsedUnit, err := parseTimeUnit(fieldOpts.unit)
+		if err != nil {
+			return time.Time{}, err
+		}
+
+		unit = parsedUnit
+	}
+
+	nanoseconds, err := scaleNumber(formatNumber(value), unit)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %w", errCannotParseTime, err)
+	}
+
+	return time.Unix(0, nanoseconds).In(fieldOpts.location), nil
+}
+
+// scaleNumber multiplies the number by the unit, checking the result for overflow.
+// Integers are multiplied as int64 to keep their precision, only fractional numbers are multiplied as float64.
+func scaleNumber(value string, unit time.Duration) (int64, error) {
+	if integerValue, err := strconv.ParseInt(value, 10, 64); err == nil {
+		product := integerValue * int64(unit)
+		if integerValue != 0 && product/integerValue != int64(unit) {
+			return 0, fmt.Errorf("%w: %s", ErrNumericOverflow, value)
+		}
+
+		return product, nil
+	}
+
+	floatValue, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	product := floatValue * float64(unit)
+	if math.IsNaN(product) || product >= math.MaxInt64 || product < math.MinInt64 {
+		return 0, fmt.Errorf("%w: %s", ErrNumericOverflow, value)
+	}
+
+	return int64(product), nil
+}
+
+func decodeLocation(targetValue reflect.Value, sourceValue any, _ fieldOptions) error {
+	if sourceValue == nil {
+		return errLocationCannotBeNil
+	}
+
+	location, err := time.LoadLocation(castToString(sourceValue))
+	if err != nil {
+		return fmt.Errorf("%w: %w", errCannotParseLocation, err)
+	}
+
+	targetValue.Set(reflect.ValueOf(location).Elem())
+
+	return nil
+}
+
+func decodeURL(targetValue reflect.Value, sourceValue any, _ fieldOptions) error {
 	if sourceValue == nil {
 		return errURLCannotBeNil
 	}
@@ -135,3 +391,216 @@ func decodeURL(targetValue reflect.Value, sourceValue any) error {
 
 	return nil
 }
+
+func decodeAddr(targetValue reflect.Value, sourceValue any, _ fieldOptions) error {
+	if sourceValue == nil {
+		return errAddrCannotBeNil
+	}
+
+	parsedAddr, err := netip.ParseAddr(castToString(sourceValue))
+	if err != nil {
+		return fmt.Errorf("%w: %w", errCannotParseAddr, err)
+	}
+
+	targetValue.Set(reflect.ValueOf(parsedAddr))
+
+	return nil
+}
+
+func decodeAddrPort(targetValue reflect.Value, sourceValue any, _ fieldOptions) error {
+	if sourceValue == nil {
+		return errAddrPortCannotBeNil
+	}
+
+	parsedAddrPort, err := netip.ParseAddrPort(castToString(sourceValue))
+	if err != nil {
+		return fmt.Errorf("%w: %w", errCannotParseAddrPort, err)
+	}
+
+	targetValue.Set(reflect.ValueOf(parsedAddrPort))
+
+	return nil
+}
+
+func decodePrefix(targetValue reflect.Value, sourceValue any, _ fieldOptions) error {
+	if sourceValue == nil {
+		return errPrefixCannotBeNil
+	}
+
+	parsedPrefix, err := netip.ParsePrefix(castToString(sourceValue))
+	if err != nil {
+		return fmt.Errorf("%w: %w", errCannotParsePrefix, err)
+	}
+
+	targetValue.Set(reflect.ValueOf(parsedPrefix))
+
+	return nil
+}
+
+func decodeIPNet(targetValue reflect.Value, sourceValue any, _ fieldOptions) error {
+	if sourceValue == nil {
+		return errIPNetCannotBeNil
+	}
+
+	_, parsedIPNet, err := net.ParseCIDR(castToString(sourceValue))
+	if err != nil {
+		return fmt.Errorf("%w: %w", errCannotParseIPNet, err)
+	}
+
+	targetValue.Set(reflect.ValueOf(*parsedIPNet))
+
+	return nil
+}
+
+func decodeHardwareAddr(targetValue reflect.Value, sourceValue any, _ fieldOptions) error {
+	if sourceValue == nil {
+		return errHardwareAddrCannotBeNil
+	}
+
+	parsedHardwareAddr, err := net.ParseMAC(castToString(sourceValue))
+	if err != nil {
+		return fmt.Errorf("%w: %w", errCannotParseHardwareAddr, err)
+	}
+
+	targetValue.Set(reflect.ValueOf(parsedHardwareAddr))
+
+	return nil
+}
+
+func decodeRegexp(targetValue reflect.Value, sourceValue any, _ fieldOptions) error {
+	if sourceValue == nil {
+		return errRegexpCannotBeNil
+	}
+
+	compiledRegexp, err := regexp.Compile(castToString(sourceValue))
+	if err != nil {
+		return fmt.Errorf("%w: %w", errCannotParseRegexp, err)
+	}
+
+	targetValue.Set(reflect.ValueOf(compiledRegexp).Elem())
+
+	return nil
+}
+
+func decodeBigInt(targetValue reflect.Value, sourceValue any, _ fieldOptions) error {
+	if sourceValue == nil {
+		return errBigIntCannotBeNil
+	}
+
+	// the base is derived from the prefix of the value, e.g. 0x for hexadecimal numbers
+	parsedBigInt, ok := new(big.Int).SetString(formatNumber(sourceValue), 0)
+	if !ok {
+		return fmt.Errorf("%w: %v", errCannotParseBigInt, sourceValue)
+	}
+
+	targetValue.Set(reflect.ValueOf(parsedBigInt).Elem())
+
+	return nil
+}
+
+func decodeBigFloat(targetValue reflect.Value, sourceValue any, _ fieldOptions) error {
+	if sourceValue == nil {
+		return errBigFloatCannotBeNil
+	}
+
+	parsedBigFloat, ok := new(big.Float).SetString(formatNumber(sourceValue))
+	if !ok {
+		return fmt.Errorf("%w: %v", errCannotParseBigFloat, sourceValue)
+	}
+
+	targetValue.Set(reflect.ValueOf(parsedBigFloat).Elem())
+
+	return nil
+}
+
+func decodeTemplate(targetValue reflect.Value, sourceValue any, fieldOpts fieldOptions) error {
+	if sourceValue == nil {
+		return errTemplateCannotBeNil
+	}
+
+	// the template is named after the path of its field, so that it can be identified in execution errors
+	parsedTemplate, err := template.New(fieldOpts.path).Parse(castToString(sourceValue))
+	if err != nil {
+		return fmt.Errorf("%w: %w", errCannotParseTemplate, err)
+	}
+
+	targetValue.Set(reflect.ValueOf(parsedTemplate).Elem())
+
+	return nil
+}
+
+func decodeFileMode(targetValue reflect.Value, sourceValue any, _ fieldOptions) error {
+	if sourceValue == nil {
+		return errFileModeCannotBeNil
+	}
+
+	var fileMode fs.FileMode
+
+	switch sV := sourceValue.(type) {
+	case string:
+		// file modes are written in octal notation as strings, e.g. "0644" or "0o755"
+		parsedFileMode, err := strconv.ParseUint(strings.TrimPrefix(strings.TrimPrefix(sV, "0o"), "0O"), 8, 32)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errCannotParseFileMode, err)
+		}
+
+		fileMode = fs.FileMode(parsedFileMode)
+	default:
+		// numbers are already converted from octal notation by the formats which support it, such as YAML
+		parsedFileMode, err := strconv.ParseUint(formatNumber(sV), 10, 32)
+		if err != nil {
+			return fmt.Errorf("%w: %w", errCannotParseFileMode, err)
+		}
+
+		fileMode = fs.FileMode(parsedFileMode)
+	}
+
+	targetValue.SetUint(uint64(fileMode))
+
+	return nil
+}
+
+func decodeLogLevel(targetValue reflect.Value, sourceValue any, _ fieldOptions) error {
+	if sourceValue == nil {
+		return errLogLevelCannotBeNil
+	}
+
+	var logLevel slog.Level
+
+	if levelNumber, err := strconv.Atoi(formatNumber(sourceValue)); err == nil {
+		logLevel = slog.Level(levelNumber)
+	} else if err := logLevel.UnmarshalText(castToBytes(sourceValue)); err != nil {
+		return fmt.Errorf("%w: %w", errCannotParseLogLevel, err)
+	}
+
+	targetValue.SetInt(int64(logLevel))
+
+	return nil
+}
+
+func decodeMailAddress(targetValue reflect.Value, sourceValue any, _ fieldOptions) error {
+	if sourceValue == nil {
+		return errMailAddressCannotBeNil
+	}
+
+	parsedMailAddress, err := mail.ParseAddress(castToString(sourceValue))
+	if err != nil {
+		return fmt.Errorf("%w: %w", errCannotParseMailAddress, err)
+	}
+
+	targetValue.Set(reflect.ValueOf(*parsedMailAddress))
+
+	return nil
+}
+
+// formatNumber formats floats without exponent notation, so that large numbers, such as the ones of JSON, can be parsed from their string representation.
+func formatNumber(value any) string {
+	switch v := value.(type) {
+	case float32:
+		return strconv.FormatFloat(float64(v), 'f', -1, 32)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	default:
+		return castToString(value)
+	}
+}
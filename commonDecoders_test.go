@@ -2,13 +2,22 @@ package confiq_test
 
 import (
 	"encoding/json"
+	"log/slog"
+	"math/big"
 	"net"
+	"net/mail"
+	"net/netip"
 	"net/url"
+	"os"
+	"regexp"
+	"strings"
 	"testing"
+	"text/template"
 	"time"
 
 	"github.com/greencoda/confiq"
 	"github.com/greencoda/confiq/mocks"
+	"github.com/pelletier/go-toml"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -92,37 +101,1121 @@ func (s *CommonDecodersTestSuite) Test_Decode_Duration_FromInvalidFormat() {
 	s.Error(decodeErr)
 }
 
+func (s *CommonDecodersTestSuite) Test_Decode_Duration_WithDaysAndWeeks() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_days":      "7d",
+		"test_fractions": "1.5d",
+		"test_combined":  "1w2d3h30m",
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestDays      time.Duration `cfg:"test_days"`
+		TestFractions time.Duration `cfg:"test_fractions"`
+		TestCombined  time.Duration `cfg:"test_combined"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(7*24*time.Hour, target.TestDays)
+	s.Equal(36*time.Hour, target.TestFractions)
+	s.Equal(9*24*time.Hour+3*time.Hour+30*time.Minute, target.TestCombined)
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Duration_FromISO8601() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_minutes":  "PT5M",
+		"test_combined": "P1DT2H",
+		"test_weeks":    "P2W",
+		"test_negative": "-PT1.5S",
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestMinutes  time.Duration `cfg:"test_minutes"`
+		TestCombined time.Duration `cfg:"test_combined"`
+		TestWeeks    time.Duration `cfg:"test_weeks"`
+		TestNegative time.Duration `cfg:"test_negative"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(5*time.Minute, target.TestMinutes)
+	s.Equal(26*time.Hour, target.TestCombined)
+	s.Equal(14*24*time.Hour, target.TestWeeks)
+	s.Equal(-1500*time.Millisecond, target.TestNegative)
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Duration_FromISO8601_WithMonths() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_duration": "P1M"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestDuration time.Duration `cfg:"test_duration,strict"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Duration_FromISO8601_WithoutComponents() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_duration": "PT"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestDuration time.Duration `cfg:"test_duration,strict"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Duration_FromNumber() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_nanoseconds": 1500,
+		"test_seconds":     float64(30),
+		"test_days":        "2",
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestNanoseconds time.Duration `cfg:"test_nanoseconds"`
+		TestSeconds     time.Duration `cfg:"test_seconds,unit=s"`
+		TestDays        time.Duration `cfg:"test_days,unit=d"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(1500*time.Nanosecond, target.TestNanoseconds)
+	s.Equal(30*time.Second, target.TestSeconds)
+	s.Equal(48*time.Hour, target.TestDays)
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Duration_FromNumber_KeepsPrecision() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_nanoseconds": int64(9007199254740993)}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestNanoseconds time.Duration `cfg:"test_nanoseconds"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(time.Duration(9007199254740993), target.TestNanoseconds)
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Duration_FromNumber_Overflow() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_integer": int64(1000000000),
+		"test_float":   1e300,
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type integerTargetStruct struct {
+		TestInteger time.Duration `cfg:"test_integer,unit=d,strict"`
+	}
+
+	type floatTargetStruct struct {
+		TestFloat time.Duration `cfg:"test_float,unit=s,strict"`
+	}
+
+	var (
+		integerTarget integerTargetStruct
+		floatTarget   floatTargetStruct
+	)
+
+	s.ErrorIs(s.configSet.Decode(&integerTarget), confiq.ErrNumericOverflow)
+	s.ErrorIs(s.configSet.Decode(&floatTarget), confiq.ErrNumericOverflow)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Duration_WithUnitTag_InSliceAndMap() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_timeouts": map[string]any{"read": 30, "write": "1m"},
+		"test_delays":   "1;2",
+		"test_created":  []any{1736751600000},
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestTimeouts map[string]time.Duration `cfg:"test_timeouts,unit=s,strict"`
+		TestDelays   [2]time.Duration         `cfg:"test_delays,unit=ms,strict"`
+		TestCreated  []time.Time              `cfg:"test_created,unit=ms,strict"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(map[string]time.Duration{"read": 30 * time.Second, "write": time.Minute}, target.TestTimeouts)
+	s.Equal([2]time.Duration{time.Millisecond, 2 * time.Millisecond}, target.TestDelays)
+	s.Equal([]time.Time{time.Date(2025, 1, 13, 7, 0, 0, 0, time.UTC)}, target.TestCreated)
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Duration_WithDefaultDurationUnit() {
+	configSet := confiq.New(
+		confiq.WithDefaultDurationUnit(time.Millisecond),
+	)
+
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_default":  250,
+		"test_override": 2,
+		"test_string":   "1m",
+	}})
+
+	loadErr := configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestDefault  time.Duration `cfg:"test_default"`
+		TestOverride time.Duration `cfg:"test_override,unit=h"`
+		TestString   time.Duration `cfg:"test_string"`
+	}
+
+	var target targetStruct
+
+	decodeErr := configSet.Decode(&target)
+
+	s.Equal(250*time.Millisecond, target.TestDefault)
+	s.Equal(2*time.Hour, target.TestOverride)
+	s.Equal(time.Minute, target.TestString)
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_ByteSize() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_decimal":  "10MB",
+		"test_binary":   "512KiB",
+		"test_fraction": "1.5 GiB",
+		"test_bytes":    "42",
+		"test_number":   float64(1000000),
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestDecimal  confiq.ByteSize  `cfg:"test_decimal"`
+		TestBinary   *confiq.ByteSize `cfg:"test_binary"`
+		TestFraction confiq.ByteSize  `cfg:"test_fraction"`
+		TestBytes    confiq.ByteSize  `cfg:"test_bytes"`
+		TestNumber   confiq.ByteSize  `cfg:"test_number"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(confiq.ByteSize(10_000_000), target.TestDecimal)
+	s.Require().NotNil(target.TestBinary)
+	s.Equal(confiq.ByteSize(512*1024), *target.TestBinary)
+	s.Equal(confiq.ByteSize(1536*1024*1024), target.TestFraction)
+	s.Equal(confiq.ByteSize(42), target.TestBytes)
+	s.Equal(confiq.ByteSize(1_000_000), target.TestNumber)
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_ByteSize_WithUnitTag_InSliceAndMap() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_limits": "10MB;1KiB",
+		"test_quotas": map[string]any{"alpha": "2GB"},
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestLimits []int            `cfg:"test_limits,unit=bytes,strict"`
+		TestQuotas map[string]int64 `cfg:"test_quotas,unit=bytes,strict"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal([]int{10000000, 1024}, target.TestLimits)
+	s.Equal(map[string]int64{"alpha": 2000000000}, target.TestQuotas)
+	s.NoError(decodeErr)
+}
+func (s *CommonDecodersTestSuite) Test_Decode_ByteSize_FromNil() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_size": nil}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestSize confiq.ByteSize `cfg:"test_size,strict"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_ByteSize_FromInvalidFormat() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_invalid_unit": "10XB",
+		"test_negative":     "-10MB",
+		"test_out_of_range": "20EiB",
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type invalidUnitStruct struct {
+		TestSize confiq.ByteSize `cfg:"test_invalid_unit,strict"`
+	}
+
+	type negativeStruct struct {
+		TestSize confiq.ByteSize `cfg:"test_negative,strict"`
+	}
+
+	type outOfRangeStruct struct {
+		TestSize confiq.ByteSize `cfg:"test_out_of_range,strict"`
+	}
+
+	s.Error(s.configSet.Decode(&invalidUnitStruct{}))
+	s.Error(s.configSet.Decode(&negativeStruct{}))
+	s.Error(s.configSet.Decode(&outOfRangeStruct{}))
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_ByteSize_IntoIntegers() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_int":      "64MiB",
+		"test_uint":     "2kb",
+		"test_overflow": "1KB",
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestInt      int    `cfg:"test_int,unit=bytes"`
+		TestUint     uint32 `cfg:"test_uint,unit=bytes"`
+		TestOverflow uint8  `cfg:"test_overflow,unit=bytes"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(64*1024*1024, target.TestInt)
+	s.Equal(uint32(2000), target.TestUint)
+	s.Zero(target.TestOverflow)
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_ByteSize_UnmarshalText() {
+	var size confiq.ByteSize
+
+	s.Require().NoError(size.UnmarshalText([]byte("4 MiB")))
+	s.Equal(confiq.ByteSize(4*1024*1024), size)
+	s.Error(size.UnmarshalText([]byte("four megabytes")))
+}
+
 func (s *CommonDecodersTestSuite) Test_Decode_IP() {
 	s.valueContainer.On("Errors").Return([]error{})
-	s.valueContainer.On("Get").Return([]any{map[string]any{"test_ip": "127.0.0.1"}})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_ip": "127.0.0.1"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestIP net.IP `cfg:"test_ip"`
+	}
+
+	var (
+		target   targetStruct
+		expected = net.ParseIP("127.0.0.1")
+	)
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(expected, target.TestIP)
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_IP_FromNil() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_ip": nil}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestIP net.IP `cfg:"test_ip"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_IP_FromInvalidFormat() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_ip_invalid_format": "127.0.0.0.1"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestIP net.IP `cfg:"test_ip_invalid_format"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Addr() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_addr": "192.168.1.10"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestAddr netip.Addr `cfg:"test_addr"`
+	}
+
+	var (
+		target   targetStruct
+		expected = netip.MustParseAddr("192.168.1.10")
+	)
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(expected, target.TestAddr)
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Addr_FromNil() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_addr": nil}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestAddr netip.Addr `cfg:"test_addr"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Addr_FromInvalidFormat() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_addr_invalid_format": "192.168.1"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestAddr netip.Addr `cfg:"test_addr_invalid_format"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_AddrPort() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_addr_port": "[::1]:8080"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestAddrPort netip.AddrPort `cfg:"test_addr_port"`
+	}
+
+	var (
+		target   targetStruct
+		expected = netip.AddrPortFrom(netip.IPv6Loopback(), 8080)
+	)
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(expected, target.TestAddrPort)
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_AddrPort_FromNil() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_addr_port": nil}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestAddrPort netip.AddrPort `cfg:"test_addr_port"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_AddrPort_FromInvalidFormat() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_addr_port_invalid_format": "127.0.0.1"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestAddrPort netip.AddrPort `cfg:"test_addr_port_invalid_format"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Prefix() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_prefix": "10.0.0.0/8"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestPrefix netip.Prefix `cfg:"test_prefix"`
+	}
+
+	var (
+		target   targetStruct
+		expected = netip.PrefixFrom(netip.AddrFrom4([4]byte{10, 0, 0, 0}), 8)
+	)
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(expected, target.TestPrefix)
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Prefix_FromNil() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_prefix": nil}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestPrefix netip.Prefix `cfg:"test_prefix"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Prefix_FromInvalidFormat() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_prefix_invalid_format": "10.0.0.0/33"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestPrefix netip.Prefix `cfg:"test_prefix_invalid_format"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_IPNet() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_ip_net": "192.168.0.0/16"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestIPNet *net.IPNet `cfg:"test_ip_net"`
+	}
+
+	var (
+		target   targetStruct
+		expected = &net.IPNet{
+			IP:   net.IPv4(192, 168, 0, 0).To4(),
+			Mask: net.CIDRMask(16, 32),
+		}
+	)
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(expected, target.TestIPNet)
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_IPNet_FromNil() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_ip_net": nil}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestIPNet *net.IPNet `cfg:"test_ip_net"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_IPNet_FromInvalidFormat() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_ip_net_invalid_format": "10.0.0.0"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestIPNet *net.IPNet `cfg:"test_ip_net_invalid_format"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_HardwareAddr() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_hardware_addr": "00:00:5e:00:53:01"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestHardwareAddr net.HardwareAddr `cfg:"test_hardware_addr"`
+	}
+
+	var (
+		target   targetStruct
+		expected = net.HardwareAddr{0x00, 0x00, 0x5e, 0x00, 0x53, 0x01}
+	)
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(expected, target.TestHardwareAddr)
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_HardwareAddr_FromNil() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_hardware_addr": nil}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestHardwareAddr net.HardwareAddr `cfg:"test_hardware_addr"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_HardwareAddr_FromInvalidFormat() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_hardware_addr_invalid_format": "00:00:5e"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestHardwareAddr net.HardwareAddr `cfg:"test_hardware_addr_invalid_format"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Regexp() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_regexp": "^[a-z]+$"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestRegexp *regexp.Regexp `cfg:"test_regexp"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Require().NotNil(target.TestRegexp)
+	s.Equal("^[a-z]+$", target.TestRegexp.String())
+	s.True(target.TestRegexp.MatchString("confiq"))
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Regexp_FromNil() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_regexp": nil}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestRegexp *regexp.Regexp `cfg:"test_regexp"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Regexp_FromInvalidFormat() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_regexp_invalid_format": "[a-z"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestRegexp *regexp.Regexp `cfg:"test_regexp_invalid_format"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_BigInt() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_big_int": "123456789012345678901234567890",
+		"test_hex":     "0xff",
+		"test_number":  float64(1e21),
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestBigInt *big.Int `cfg:"test_big_int"`
+		TestHex    big.Int  `cfg:"test_hex"`
+		TestNumber *big.Int `cfg:"test_number"`
+	}
+
+	var (
+		target      targetStruct
+		expected, _ = new(big.Int).SetString("123456789012345678901234567890", 10)
+	)
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Require().NotNil(target.TestBigInt)
+	s.Zero(expected.Cmp(target.TestBigInt))
+	s.Zero(big.NewInt(255).Cmp(&target.TestHex))
+	s.Require().NotNil(target.TestNumber)
+	s.Equal("1000000000000000000000", target.TestNumber.String())
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_BigInt_FromNil() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_big_int": nil}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestBigInt *big.Int `cfg:"test_big_int"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_BigInt_FromInvalidFormat() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_big_int_invalid_format": "12ab"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestBigInt *big.Int `cfg:"test_big_int_invalid_format"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_BigFloat() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_big_float": "3.25"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestBigFloat *big.Float `cfg:"test_big_float"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Require().NotNil(target.TestBigFloat)
+	s.Zero(big.NewFloat(3.25).Cmp(target.TestBigFloat))
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_BigFloat_FromNil() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_big_float": nil}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestBigFloat *big.Float `cfg:"test_big_float"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_BigFloat_FromInvalidFormat() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_big_float_invalid_format": "pi"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestBigFloat *big.Float `cfg:"test_big_float_invalid_format"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Template() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_template": "Hello, {{.Name}}!"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestTemplate *template.Template `cfg:"test_template"`
+	}
+
+	var (
+		target targetStruct
+		output strings.Builder
+	)
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Require().NotNil(target.TestTemplate)
+	s.Require().NoError(target.TestTemplate.Execute(&output, map[string]string{"Name": "Gopher"}))
+	s.Equal("Hello, Gopher!", output.String())
+	s.Equal("test_template", target.TestTemplate.Name())
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Template_FromNil() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_template": nil}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestTemplate *template.Template `cfg:"test_template"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Template_FromInvalidFormat() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_template_invalid_format": "Hello, {{.Name"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestTemplate *template.Template `cfg:"test_template_invalid_format"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_FileMode() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_file_mode":   "0644",
+		"test_file_mode_o": "0o755",
+		"test_number":      420,
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestFileMode  os.FileMode `cfg:"test_file_mode"`
+		TestFileModeO os.FileMode `cfg:"test_file_mode_o"`
+		TestNumber    os.FileMode `cfg:"test_number"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(os.FileMode(0o644), target.TestFileMode)
+	s.Equal(os.FileMode(0o755), target.TestFileModeO)
+	s.Equal(os.FileMode(0o644), target.TestNumber)
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_FileMode_FromNil() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_file_mode": nil}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestFileMode os.FileMode `cfg:"test_file_mode"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_FileMode_FromInvalidFormat() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_file_mode_invalid_format": "0999"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestFileMode os.FileMode `cfg:"test_file_mode_invalid_format"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_LogLevel() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_log_level": "warn",
+		"test_offset":    "INFO+2",
+		"test_number":    8,
+	}})
 
 	loadErr := s.configSet.Load(s.valueContainer)
 	s.Require().NoError(loadErr)
 
 	type targetStruct struct {
-		TestIP net.IP `cfg:"test_ip"`
+		TestLogLevel slog.Level `cfg:"test_log_level"`
+		TestOffset   slog.Level `cfg:"test_offset"`
+		TestNumber   slog.Level `cfg:"test_number"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(slog.LevelWarn, target.TestLogLevel)
+	s.Equal(slog.LevelInfo+2, target.TestOffset)
+	s.Equal(slog.LevelError, target.TestNumber)
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_LogLevel_FromNil() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_log_level": nil}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestLogLevel slog.Level `cfg:"test_log_level"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_LogLevel_FromInvalidFormat() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_log_level_invalid_format": "verbose"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestLogLevel slog.Level `cfg:"test_log_level_invalid_format"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_MailAddress() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_mail_address": "Gopher <gopher@example.com>"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestMailAddress *mail.Address `cfg:"test_mail_address"`
 	}
 
 	var (
 		target   targetStruct
-		expected = net.ParseIP("127.0.0.1")
+		expected = &mail.Address{
+			Name:    "Gopher",
+			Address: "gopher@example.com",
+		}
 	)
 
 	decodeErr := s.configSet.Decode(&target)
 
-	s.Equal(expected, target.TestIP)
+	s.Equal(expected, target.TestMailAddress)
 	s.NoError(decodeErr)
 }
 
-func (s *CommonDecodersTestSuite) Test_Decode_IP_FromNil() {
+func (s *CommonDecodersTestSuite) Test_Decode_MailAddress_FromNil() {
 	s.valueContainer.On("Errors").Return([]error{})
-	s.valueContainer.On("Get").Return([]any{map[string]any{"test_ip": nil}})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_mail_address": nil}})
 
 	loadErr := s.configSet.Load(s.valueContainer)
 	s.Require().NoError(loadErr)
 
 	type targetStruct struct {
-		TestIP net.IP `cfg:"test_ip"`
+		TestMailAddress *mail.Address `cfg:"test_mail_address"`
 	}
 
 	var target targetStruct
@@ -132,15 +1225,15 @@ func (s *CommonDecodersTestSuite) Test_Decode_IP_FromNil() {
 	s.Error(decodeErr)
 }
 
-func (s *CommonDecodersTestSuite) Test_Decode_IP_FromInvalidFormat() {
+func (s *CommonDecodersTestSuite) Test_Decode_MailAddress_FromInvalidFormat() {
 	s.valueContainer.On("Errors").Return([]error{})
-	s.valueContainer.On("Get").Return([]any{map[string]any{"test_ip_invalid_format": "127.0.0.0.1"}})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_mail_address_invalid_format": "gopher@"}})
 
 	loadErr := s.configSet.Load(s.valueContainer)
 	s.Require().NoError(loadErr)
 
 	type targetStruct struct {
-		TestIP net.IP `cfg:"test_ip_invalid_format"`
+		TestMailAddress *mail.Address `cfg:"test_mail_address_invalid_format"`
 	}
 
 	var target targetStruct
@@ -335,6 +1428,315 @@ func (s *CommonDecodersTestSuite) Test_Decode_Time_FromInvalidFormat() {
 	s.Error(decodeErr)
 }
 
+func (s *CommonDecodersTestSuite) Test_Decode_Time_FromDateOnly() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_time": "2025-01-13"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestTime time.Time `cfg:"test_time"`
+	}
+
+	var (
+		target   targetStruct
+		expected = time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC)
+	)
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(expected, target.TestTime)
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Time_FromTOMLLocalDateTime() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_date": toml.LocalDate{Year: 1979, Month: time.May, Day: 27},
+		"test_datetime": toml.LocalDateTime{
+			Date: toml.LocalDate{Year: 1979, Month: time.May, Day: 27},
+			Time: toml.LocalTime{Hour: 7, Minute: 32, Second: 0, Nanosecond: 999999000},
+		},
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestDate     time.Time `cfg:"test_date"`
+		TestDateTime time.Time `cfg:"test_datetime"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(time.Date(1979, 5, 27, 0, 0, 0, 0, time.UTC), target.TestDate)
+	s.Equal(time.Date(1979, 5, 27, 7, 32, 0, 999999000, time.UTC), target.TestDateTime)
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Time_WithDefaultLocation() {
+	location, err := time.LoadLocation("Asia/Tokyo")
+	s.Require().NoError(err)
+
+	configSet := confiq.New(
+		confiq.WithDefaultLocation(location),
+	)
+
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_local_time": "2025-01-13 16:00:00",
+		"test_utc_time":   "2025-01-13T16:00:00Z",
+	}})
+
+	loadErr := configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestLocalTime time.Time `cfg:"test_local_time"`
+		TestUTCTime   time.Time `cfg:"test_utc_time"`
+	}
+
+	var target targetStruct
+
+	decodeErr := configSet.Decode(&target)
+
+	s.Equal(time.Date(2025, 1, 13, 16, 0, 0, 0, location), target.TestLocalTime)
+	s.Equal(time.Date(2025, 1, 13, 16, 0, 0, 0, time.UTC), target.TestUTCTime)
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Time_WithTimeLayouts() {
+	configSet := confiq.New(
+		confiq.WithTimeLayouts("02/01/2006", "02/01/2006 15:04"),
+	)
+
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_date":     "13/01/2025",
+		"test_datetime": "13/01/2025 16:00",
+	}})
+
+	loadErr := configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestDate     time.Time `cfg:"test_date"`
+		TestDateTime time.Time `cfg:"test_datetime"`
+	}
+
+	var target targetStruct
+
+	decodeErr := configSet.Decode(&target)
+
+	s.Equal(time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC), target.TestDate)
+	s.Equal(time.Date(2025, 1, 13, 16, 0, 0, 0, time.UTC), target.TestDateTime)
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Time_WithLayoutTag() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_date":         "2025.01.13",
+		"test_invalid_date": "2025-01-13",
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestDate        time.Time `cfg:"test_date,layout=2006.01.02"`
+		TestInvalidDate time.Time `cfg:"test_invalid_date,layout=2006.01.02"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(time.Date(2025, 1, 13, 0, 0, 0, 0, time.UTC), target.TestDate)
+	s.Zero(target.TestInvalidDate)
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Time_WithLayoutTag_InSliceAndMap() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_dates":     "05/03/2024;06/03/2024",
+		"test_deadlines": map[string]any{"alpha": "05/03/2024 12:00"},
+		"test_array":     []any{"05/03/2024"},
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestDates     []time.Time          `cfg:"test_dates,layout=02/01/2006,strict"`
+		TestDeadlines map[string]time.Time `cfg:"test_deadlines,layout=02/01/2006 15:04,strict"`
+		TestArray     [1]time.Time         `cfg:"test_array,layout=02/01/2006,strict"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal([]time.Time{
+		time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 6, 0, 0, 0, 0, time.UTC),
+	}, target.TestDates)
+	s.Equal(map[string]time.Time{"alpha": time.Date(2024, 3, 5, 12, 0, 0, 0, time.UTC)}, target.TestDeadlines)
+	s.Equal([1]time.Time{time.Date(2024, 3, 5, 0, 0, 0, 0, time.UTC)}, target.TestArray)
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Time_FromUnixTimestamp() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_seconds":      int64(1736751600),
+		"test_float":        1736751600.5,
+		"test_milliseconds": 1736751600000,
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestSeconds      time.Time `cfg:"test_seconds"`
+		TestFloat        time.Time `cfg:"test_float"`
+		TestMilliseconds time.Time `cfg:"test_milliseconds,unit=ms"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(time.Date(2025, 1, 13, 7, 0, 0, 0, time.UTC), target.TestSeconds)
+	s.Equal(time.Date(2025, 1, 13, 7, 0, 0, 500000000, time.UTC), target.TestFloat)
+	s.Equal(time.Date(2025, 1, 13, 7, 0, 0, 0, time.UTC), target.TestMilliseconds)
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Time_FromUnixTimestamp_Nanoseconds() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_nanoseconds": int64(1736751600123456789)}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestNanoseconds time.Time `cfg:"test_nanoseconds,unit=ns"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(time.Date(2025, 1, 13, 7, 0, 0, 123456789, time.UTC), target.TestNanoseconds)
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Time_FromUnixTimestamp_Overflow() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_integer": int64(1736751600000),
+		"test_float":   1e300,
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type integerTargetStruct struct {
+		TestInteger time.Time `cfg:"test_integer,strict"`
+	}
+
+	type floatTargetStruct struct {
+		TestFloat time.Time `cfg:"test_float,strict"`
+	}
+
+	var (
+		integerTarget integerTargetStruct
+		floatTarget   floatTargetStruct
+	)
+
+	s.ErrorIs(s.configSet.Decode(&integerTarget), confiq.ErrNumericOverflow)
+	s.ErrorIs(s.configSet.Decode(&floatTarget), confiq.ErrNumericOverflow)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Time_FromUnixTimestamp_WithInvalidUnit() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_seconds": 1736751600}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestSeconds time.Time `cfg:"test_seconds,unit=fortnight,strict"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Location() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_location": "Europe/Budapest"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestLocation *time.Location `cfg:"test_location"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Require().NotNil(target.TestLocation)
+	s.Equal("Europe/Budapest", target.TestLocation.String())
+	s.NoError(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Location_FromNil() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_location": nil}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestLocation time.Location `cfg:"test_location"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *CommonDecodersTestSuite) Test_Decode_Location_FromInvalidFormat() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_location": "Europe/Atlantis"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestLocation *time.Location `cfg:"test_location,strict"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
 func (s *CommonDecodersTestSuite) Test_Decode_URL_Ptr() {
 	s.valueContainer.On("Errors").Return([]error{})
 	s.valueContainer.On("Get").Return([]any{map[string]any{"test_url": "http://www.test.com"}})
@@ -5,30 +5,58 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"slices"
+	"strings"
+	"time"
 )
 
 const defaultTag = "cfg"
 
+// maxSliceLength limits the length to which Set grows slices, so that a large index cannot allocate an arbitrarily large slice.
+const maxSliceLength = 1 << 16
+
 var (
-	errCannotGetKeyFromNonMap     = errors.New("cannot get key from non-map type")
-	errKeyNotFound                = errors.New("key not found")
-	errCannotGetIndexFromNonSlice = errors.New("cannot get index from non-slice type")
-	errIndexOutOfBounds           = errors.New("index out of bounds")
+	errCannotGetKeyFromNonMap      = errors.New("cannot get key from non-map type")
+	errKeyNotFound                 = errors.New("key not found")
+	errCannotGetIndexFromNonSlice  = errors.New("cannot get index from non-slice type")
+	errIndexOutOfBounds            = errors.New("index out of bounds")
+	errCannotSetKeyOnNonMap        = errors.New("cannot set key on non-map type")
+	errCannotSetIndexOnNonSlice    = errors.New("cannot set index on non-slice type")
+	errCannotGetKeysOfNonContainer = errors.New("cannot get keys of non-map, non-slice type")
 )
 
 type decoder struct {
-	tag string
+	tag               string
+	namingStrategy    NamingStrategy
+	polymorphicTypes  map[reflect.Type]polymorphicType
+	sliceSeparator    string
+	keyValueSeparator string
+	timeLayouts       []string
+	location          *time.Location
+	durationUnit      time.Duration
+	boolValues        map[string]bool
+	strictBools       bool
+	fileLoaders       map[string]FileLoader
+}
+
+type polymorphicType struct {
+	discriminatorKey string
+	implementations  map[string]reflect.Type
 }
 
 type decodeSettings struct {
-	strict bool
-	prefix string
+	strict              bool
+	prefix              string
+	disallowUnknownKeys bool
+	unusedKeysHandler   func(unusedKeys []string)
 }
 
 // ConfigSet is a configuration set that can be used to load and decode configuration values into a struct.
 type ConfigSet struct {
 	value   *any
 	decoder *decoder
+	path    string
+	usage   *usageTracker
 }
 
 // New creates a new ConfigSet with the given options.
@@ -36,8 +64,22 @@ func New(options ...configSetOption) *ConfigSet {
 	var (
 		value     any
 		configSet = &ConfigSet{
-			value:   &value,
-			decoder: &decoder{tag: defaultTag},
+			value: &value,
+			decoder: &decoder{
+				tag:               defaultTag,
+				namingStrategy:    nil,
+				polymorphicTypes:  map[reflect.Type]polymorphicType{},
+				sliceSeparator:    defaultSliceSeparator,
+				keyValueSeparator: "",
+				timeLayouts:       defaultTimeLayouts,
+				location:          time.UTC,
+				durationUnit:      time.Nanosecond,
+				boolValues:        defaultBoolValues,
+				strictBools:       false,
+				fileLoaders:       map[string]FileLoader{},
+			},
+			path:  "",
+			usage: nil,
 		}
 	)
 
@@ -53,6 +95,56 @@ func (c *ConfigSet) Get(path string) (any, error) {
 	return c.getByPath(path)
 }
 
+// Has reports whether a configuration value exists at the given path.
+func (c *ConfigSet) Has(path string) bool {
+	_, err := c.getByPath(path)
+
+	return err == nil
+}
+
+// Keys returns the keys of the map or the indices of the slice at the given path, in the form of path segments.
+// Map keys are returned in sorted order, slice indices are returned in their selector form, e.g. "[0]".
+func (c *ConfigSet) Keys(path string) ([]string, error) {
+	value, err := c.getByPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	children, isContainer := getChildren(value)
+	if !isContainer {
+		return nil, fmt.Errorf("%w: %T", errCannotGetKeysOfNonContainer, value)
+	}
+
+	keys := make([]string, 0, len(children))
+
+	for _, child := range children {
+		keys = append(keys, child.segment.String())
+	}
+
+	return keys, nil
+}
+
+// Walk calls fn for every leaf value of the config set with its path in canonical selector syntax.
+// Maps are traversed in sorted key order, empty maps and slices are considered to be leaves.
+func (c *ConfigSet) Walk(fn func(path string, value any)) {
+	if *c.value == nil {
+		return
+	}
+
+	walkValue("", *c.value, fn)
+}
+
+// Sub returns a ConfigSet rooted at the given path, which shares its values and options with the original.
+// If there is no value at the given path, the returned ConfigSet is empty.
+func (c *ConfigSet) Sub(path string) *ConfigSet {
+	value, err := c.getByPath(path)
+	if err != nil {
+		return c.subValue(nil)
+	}
+
+	return c.subValue(value)
+}
+
 func (c *ConfigSet) getByPath(path string) (any, error) {
 	currentValue := *c.value
 
@@ -82,6 +174,214 @@ func (c *ConfigSet) getByPath(path string) (any, error) {
 	return currentValue, nil
 }
 
+// Set sets the configuration value at the given path, creating intermediate maps and growing slices as needed.
+// Slices are not grown beyond 65536 elements, and setting an index beyond that returns an error.
+func (c *ConfigSet) Set(path string, value any) error {
+	newValue, err := setByPath(*c.value, path, value)
+	if err != nil {
+		return err
+	}
+
+	*c.value = newValue
+
+	return nil
+}
+
+// Delete removes the configuration value at the given path.
+// Deleting an element of a slice shifts the elements following it.
+func (c *ConfigSet) Delete(path string) error {
+	if path == "" {
+		*c.value = nil
+
+		return nil
+	}
+
+	newValue, err := deleteByPath(*c.value, path)
+	if err != nil {
+		return err
+	}
+
+	*c.value = newValue
+
+	return nil
+}
+
+func setByPath(currentValue any, path string, value any) (any, error) {
+	if path == "" {
+		return value, nil
+	}
+
+	currentSegment, remainingPath := getNextSegment(path)
+
+	if v, ok := currentSegment.(indexSegment); ok {
+		return setSliceValue(currentValue, v.asInt(), remainingPath, value)
+	}
+
+	return setMapValue(currentValue, currentSegment.String(), remainingPath, value)
+}
+
+func setMapValue(originMap any, key string, remainingPath string, value any) (any, error) {
+	if originMap == nil {
+		originMap = map[string]any{}
+	}
+
+	valueMap, ok := originMap.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s(%T)", errCannotSetKeyOnNonMap, key, originMap)
+	}
+
+	newValue, err := setByPath(valueMap[key], remainingPath, value)
+	if err != nil {
+		return nil, err
+	}
+
+	valueMap[key] = newValue
+
+	return valueMap, nil
+}
+
+func setSliceValue(originSlice any, index int, remainingPath string, value any) (any, error) {
+	if index < 0 || index >= maxSliceLength {
+		return nil, fmt.Errorf("%w: %d", errIndexOutOfBounds, index)
+	}
+
+	if originSlice == nil {
+		originSlice = []any{}
+	}
+
+	valueSlice, ok := originSlice.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: %d(%T)", errCannotSetIndexOnNonSlice, index, originSlice)
+	}
+
+	if index >= len(valueSlice) {
+		valueSlice = append(valueSlice, make([]any, index-len(valueSlice)+1)...)
+	}
+
+	newValue, err := setByPath(valueSlice[index], remainingPath, value)
+	if err != nil {
+		return nil, err
+	}
+
+	valueSlice[index] = newValue
+
+	return valueSlice, nil
+}
+
+func deleteByPath(currentValue any, path string) (any, error) {
+	currentSegment, remainingPath := getNextSegment(path)
+
+	if v, ok := currentSegment.(indexSegment); ok {
+		return deleteSliceValue(currentValue, v.asInt(), remainingPath)
+	}
+
+	return deleteMapValue(currentValue, currentSegment.String(), remainingPath)
+}
+
+func deleteMapValue(originMap any, key string, remainingPath string) (any, error) {
+	valueMap, ok := originMap.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s(%T)", errCannotGetKeyFromNonMap, key, originMap)
+	}
+
+	value, ok := valueMap[key]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", errKeyNotFound, key)
+	}
+
+	if remainingPath == "" {
+		delete(valueMap, key)
+
+		return valueMap, nil
+	}
+
+	newValue, err := deleteByPath(value, remainingPath)
+	if err != nil {
+		return nil, err
+	}
+
+	valueMap[key] = newValue
+
+	return valueMap, nil
+}
+
+func deleteSliceValue(originSlice any, index int, remainingPath string) (any, error) {
+	valueSlice, ok := originSlice.([]any)
+	if !ok {
+		return nil, fmt.Errorf("%w: %d(%T)", errCannotGetIndexFromNonSlice, index, originSlice)
+	}
+
+	if len(valueSlice) <= index || index < 0 {
+		return nil, fmt.Errorf("%w: %d", errIndexOutOfBounds, index)
+	}
+
+	if remainingPath == "" {
+		return slices.Delete(valueSlice, index, index+1), nil
+	}
+
+	newValue, err := deleteByPath(valueSlice[index], remainingPath)
+	if err != nil {
+		return nil, err
+	}
+
+	valueSlice[index] = newValue
+
+	return valueSlice, nil
+}
+
+type child struct {
+	segment segment
+	value   any
+}
+
+func getChildren(value any) ([]child, bool) {
+	v := reflect.ValueOf(value)
+
+	switch v.Kind() {
+	case reflect.Map:
+		children := make([]child, 0, v.Len())
+
+		for _, key := range v.MapKeys() {
+			children = append(children, child{
+				segment: keySegment(fmt.Sprint(key.Interface())),
+				value:   v.MapIndex(key).Interface(),
+			})
+		}
+
+		slices.SortFunc(children, func(a, b child) int {
+			return strings.Compare(a.segment.String(), b.segment.String())
+		})
+
+		return children, true
+	case reflect.Slice:
+		children := make([]child, 0, v.Len())
+
+		for i := range v.Len() {
+			children = append(children, child{
+				segment: indexSegment(i),
+				value:   v.Index(i).Interface(),
+			})
+		}
+
+		return children, true
+	default:
+		return nil, false
+	}
+}
+
+func walkValue(path string, value any, fn func(path string, value any)) {
+	children, isContainer := getChildren(value)
+	if !isContainer || len(children) == 0 {
+		fn(path, value)
+
+		return
+	}
+
+	for _, child := range children {
+		walkValue(appendSegment(path, child.segment), child.value, fn)
+	}
+}
+
 func getMapValue(originMap any, key string) (any, error) {
 	v := reflect.ValueOf(originMap)
 	if v.Kind() != reflect.Map {
@@ -184,3 +184,296 @@ func (s *ConfigSetTestSuite) Test_Get_FromArray_WithInvalidIndexPath() {
 	s.Empty(value)
 	s.Error(getErr)
 }
+
+func (s *ConfigSetTestSuite) Test_Set() {
+	setErr := s.configSet.Set("test_section.test_string", "test")
+	s.Require().NoError(setErr)
+
+	value, getErr := s.configSet.Get("test_section.test_string")
+
+	s.Equal("test", value)
+	s.NoError(getErr)
+}
+
+func (s *ConfigSetTestSuite) Test_Set_OverridesLoadedValue() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{
+		map[string]any{"test_section": map[string]any{
+			"test_string": "test",
+			"test_int":    1,
+		}},
+	})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	setErr := s.configSet.Set("test_section.test_string", "overridden")
+	s.Require().NoError(setErr)
+
+	value, getErr := s.configSet.Get("test_section")
+
+	s.Equal(map[string]any{"test_string": "overridden", "test_int": 1}, value)
+	s.NoError(getErr)
+}
+
+func (s *ConfigSetTestSuite) Test_Set_GrowsSlice() {
+	setErr := s.configSet.Set("test_servers[2].port", 80)
+	s.Require().NoError(setErr)
+
+	value, getErr := s.configSet.Get("test_servers")
+
+	s.Equal([]any{nil, nil, map[string]any{"port": 80}}, value)
+	s.NoError(getErr)
+}
+
+func (s *ConfigSetTestSuite) Test_Set_Root() {
+	setErr := s.configSet.Set("", []any{"aleph"})
+	s.Require().NoError(setErr)
+
+	value, getErr := s.configSet.Get("[0]")
+
+	s.Equal("aleph", value)
+	s.NoError(getErr)
+}
+
+func (s *ConfigSetTestSuite) Test_Set_KeyOnNonMap() {
+	s.configSet.OverrideValue(map[string]any{"test_string": "test"})
+
+	setErr := s.configSet.Set("test_string.test_key", "test")
+
+	s.Error(setErr)
+}
+
+func (s *ConfigSetTestSuite) Test_Set_IndexOnNonSlice() {
+	s.configSet.OverrideValue(map[string]any{"test_string": "test"})
+
+	setErr := s.configSet.Set("test_string[0]", "test")
+
+	s.Error(setErr)
+}
+
+func (s *ConfigSetTestSuite) Test_Set_NegativeIndex() {
+	setErr := s.configSet.Set("test_slice[-1]", "test")
+
+	s.Error(setErr)
+}
+
+func (s *ConfigSetTestSuite) Test_Set_IndexTooLarge() {
+	for _, path := range []string{"test_slice[65536]", "test_slice[999999999999999999]"} {
+		setErr := s.configSet.Set(path, "test")
+
+		s.Error(setErr, path)
+		s.False(s.configSet.Has("test_slice"), path)
+	}
+}
+
+func (s *ConfigSetTestSuite) Test_Delete() {
+	s.configSet.OverrideValue(map[string]any{"test_section": map[string]any{
+		"test_string": "test",
+		"test_int":    1,
+	}})
+
+	deleteErr := s.configSet.Delete("test_section.test_string")
+	s.Require().NoError(deleteErr)
+
+	value, getErr := s.configSet.Get("test_section")
+
+	s.Equal(map[string]any{"test_int": 1}, value)
+	s.NoError(getErr)
+}
+
+func (s *ConfigSetTestSuite) Test_Delete_SliceElement() {
+	s.configSet.OverrideValue(map[string]any{"test_section": map[string]any{
+		"test_string_array": []any{"aleph", "beth", "gimel"},
+	}})
+
+	deleteErr := s.configSet.Delete("test_section.test_string_array[1]")
+	s.Require().NoError(deleteErr)
+
+	value, getErr := s.configSet.Get("test_section.test_string_array")
+
+	s.Equal([]any{"aleph", "gimel"}, value)
+	s.NoError(getErr)
+}
+
+func (s *ConfigSetTestSuite) Test_Delete_NestedInSlice() {
+	s.configSet.OverrideValue([]any{
+		map[string]any{"test_value": "test_1", "test_other_value": "test_2"},
+	})
+
+	deleteErr := s.configSet.Delete("[0].test_value")
+	s.Require().NoError(deleteErr)
+
+	value, getErr := s.configSet.Get("[0]")
+
+	s.Equal(map[string]any{"test_other_value": "test_2"}, value)
+	s.NoError(getErr)
+}
+
+func (s *ConfigSetTestSuite) Test_Delete_Root() {
+	s.configSet.OverrideValue(map[string]any{"test_string": "test"})
+
+	deleteErr := s.configSet.Delete("")
+	s.Require().NoError(deleteErr)
+
+	value, getErr := s.configSet.Get("")
+
+	s.Nil(value)
+	s.NoError(getErr)
+}
+
+func (s *ConfigSetTestSuite) Test_Delete_MissingKey() {
+	s.configSet.OverrideValue(map[string]any{"test_string": "test"})
+
+	deleteErr := s.configSet.Delete("test_section.test_string")
+
+	s.Error(deleteErr)
+}
+
+func (s *ConfigSetTestSuite) Test_Delete_IndexOutOfBounds() {
+	s.configSet.OverrideValue([]any{"aleph"})
+
+	deleteErr := s.configSet.Delete("[1]")
+
+	s.Error(deleteErr)
+}
+
+func (s *ConfigSetTestSuite) Test_Delete_IndexFromNonSlice() {
+	s.configSet.OverrideValue(map[string]any{"test_string": "test"})
+
+	deleteErr := s.configSet.Delete("[0]")
+
+	s.Error(deleteErr)
+}
+
+func (s *ConfigSetTestSuite) Test_Has() {
+	s.configSet.OverrideValue(map[string]any{"test_section": map[string]any{
+		"test_string_array": []any{"aleph", "beth", "gimel"},
+	}})
+
+	s.True(s.configSet.Has("test_section.test_string_array[2]"))
+	s.False(s.configSet.Has("test_section.test_string_array[3]"))
+	s.False(s.configSet.Has("nonexistent_key"))
+}
+
+func (s *ConfigSetTestSuite) Test_Keys_FromMap() {
+	s.configSet.OverrideValue(map[string]any{"test_section": map[string]any{
+		"test_string": "test",
+		"test_int":    1,
+		"test_bool":   true,
+	}})
+
+	keys, keysErr := s.configSet.Keys("test_section")
+
+	s.Equal([]string{"test_bool", "test_int", "test_string"}, keys)
+	s.NoError(keysErr)
+}
+
+func (s *ConfigSetTestSuite) Test_Keys_FromSlice() {
+	s.configSet.OverrideValue(map[string]any{"test_string_array": []any{"aleph", "beth"}})
+
+	keys, keysErr := s.configSet.Keys("test_string_array")
+
+	s.Equal([]string{"[0]", "[1]"}, keys)
+	s.NoError(keysErr)
+}
+
+func (s *ConfigSetTestSuite) Test_Keys_FromPrimitive() {
+	s.configSet.OverrideValue(map[string]any{"test_string": "test"})
+
+	keys, keysErr := s.configSet.Keys("test_string")
+
+	s.Empty(keys)
+	s.Error(keysErr)
+}
+
+func (s *ConfigSetTestSuite) Test_Keys_WithInvalidPath() {
+	s.configSet.OverrideValue(map[string]any{"test_string": "test"})
+
+	keys, keysErr := s.configSet.Keys("nonexistent_key")
+
+	s.Empty(keys)
+	s.Error(keysErr)
+}
+
+func (s *ConfigSetTestSuite) Test_Walk() {
+	s.configSet.OverrideValue(map[string]any{
+		"test_section": map[string]any{
+			"test_string_array": []any{"aleph", "beth"},
+			"test_empty_map":    map[string]any{},
+		},
+		"test_servers": []any{
+			map[string]any{"port": 80},
+		},
+		"test_string": "test",
+	})
+
+	walked := map[string]any{}
+
+	s.configSet.Walk(func(path string, value any) {
+		walked[path] = value
+	})
+
+	s.Equal(map[string]any{
+		"test_section.test_empty_map":       map[string]any{},
+		"test_section.test_string_array[0]": "aleph",
+		"test_section.test_string_array[1]": "beth",
+		"test_servers[0].port":              80,
+		"test_string":                       "test",
+	}, walked)
+}
+
+func (s *ConfigSetTestSuite) Test_Walk_Empty() {
+	walkedCount := 0
+
+	s.configSet.Walk(func(_ string, _ any) {
+		walkedCount++
+	})
+
+	s.Zero(walkedCount)
+}
+
+func (s *ConfigSetTestSuite) Test_Sub() {
+	s.configSet.OverrideValue(map[string]any{"test_section": map[string]any{
+		"test_string": "test",
+	}})
+
+	type JSONPrimitives struct {
+		TestString string `cfg:"test_string"`
+	}
+
+	var jsonPrimitives JSONPrimitives
+
+	subConfigSet := s.configSet.Sub("test_section")
+
+	decodeErr := subConfigSet.Decode(&jsonPrimitives)
+
+	s.Equal(JSONPrimitives{
+		TestString: "test",
+	}, jsonPrimitives)
+	s.NoError(decodeErr)
+	s.True(subConfigSet.Has("test_string"))
+}
+
+func (s *ConfigSetTestSuite) Test_Sub_SharesValues() {
+	s.configSet.OverrideValue(map[string]any{"test_section": map[string]any{
+		"test_string": "test",
+	}})
+
+	setErr := s.configSet.Sub("test_section").Set("test_string", "overridden")
+	s.Require().NoError(setErr)
+
+	value, getErr := s.configSet.Get("test_section.test_string")
+
+	s.Equal("overridden", value)
+	s.NoError(getErr)
+}
+
+func (s *ConfigSetTestSuite) Test_Sub_WithInvalidPath() {
+	s.configSet.OverrideValue(map[string]any{"test_string": "test"})
+
+	value, getErr := s.configSet.Sub("nonexistent_key").Get("")
+
+	s.Nil(value)
+	s.NoError(getErr)
+}
@@ -6,35 +6,56 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 )
 
-const sliceSplitChar = ";"
+const (
+	defaultSliceSeparator     = ";"
+	defaultKeyValueSeparators = ":="
+	skipFieldTag              = "-"
+	tagOptionSeparator        = ","
+)
 
 // Collection of decode errors.
 var (
 	ErrInvalidTarget        = errors.New("target must be non-nil pointer to a slice, map or struct that has at least one exported field with a the configured tag")
 	ErrNoTargetFieldsAreSet = errors.New("none of the target fields were set from config values")
+	ErrUnknownKeys          = errors.New("config contains keys which are not used by any of the target fields")
 )
 
 var (
 	errCannotDecodeCustomTypeField       = errors.New("cannot decode field with custom decoder")
 	errCannotDecodeNonRequiredField      = errors.New("cannot decode non-strict field")
 	errCannotDecodeNonSliceValueToTarget = errors.New("cannot decode non-slice value to target")
+	errCannotDecodeNonMapValueToTarget   = errors.New("cannot decode non-map value to target")
+	errInvalidKeyValuePair               = errors.New("invalid key-value pair")
+	errArrayLengthMismatch               = errors.New("config slice length does not match target array length")
 	errCannotUnmarshalPrimitive          = errors.New("cannot unmarshal primitive as text")
 	errCannotHaveDefaultForRequiredField = errors.New("cannot have default value for required field")
 	errUnsupportedPrimitiveKind          = errors.New("unsupported primitive kind")
+	errUnregisteredInterfaceType         = errors.New("interface type has no registered implementations")
+	errMissingDiscriminator              = errors.New("discriminator value is missing")
+	errUnknownDiscriminator              = errors.New("no implementation is registered for discriminator value")
 )
 
 type (
-	decoderFunc      func(targetField reflect.Value, value any) error
-	fieldDecoderFunc func(targetField reflect.Value, value any, strict bool) (int, error)
+	decoderFunc      func(targetField reflect.Value, value any, fieldOpts fieldOptions) error
+	fieldDecoderFunc func(targetField reflect.Value, value any, fieldOpts fieldOptions) (int, error)
 )
 
 type fieldOptions struct {
-	path         string
-	strict       bool
-	required     bool
-	defaultValue *string
+	path              string
+	skip              bool
+	strict            bool
+	required          bool
+	defaultValue      *string
+	separator         string
+	keyValueSeparator string
+	timeLayouts       []string
+	location          *time.Location
+	unit              string
+	durationUnit      time.Duration
+	encoding          string
 }
 
 type Decoder interface {
@@ -48,8 +69,10 @@ func (c *ConfigSet) Decode(target interface{}, options ...decodeOption) error {
 
 func (c *ConfigSet) decode(target interface{}, options []decodeOption) error {
 	decodeSettings := &decodeSettings{
-		strict: false,
-		prefix: "",
+		strict:              false,
+		prefix:              "",
+		disallowUnknownKeys: false,
+		unusedKeysHandler:   nil,
 	}
 
 	for _, option := range options {
@@ -63,11 +86,24 @@ func (c *ConfigSet) decode(target interface{}, options []decodeOption) error {
 
 	targetValue = targetValue.Elem()
 
-	decodedFieldCount, err := c.decodeField(targetValue, fieldOptions{
-		path:         decodeSettings.prefix,
-		strict:       decodeSettings.strict,
-		required:     false,
-		defaultValue: nil,
+	decodeSet := c.subValue(*c.value)
+	if decodeSettings.disallowUnknownKeys || decodeSettings.unusedKeysHandler != nil {
+		decodeSet.usage = new(usageTracker)
+	}
+
+	decodedFieldCount, err := decodeSet.decodeField(targetValue, fieldOptions{
+		path:              decodeSettings.prefix,
+		skip:              false,
+		strict:            decodeSettings.strict,
+		required:          false,
+		defaultValue:      nil,
+		separator:         "",
+		keyValueSeparator: "",
+		timeLayouts:       nil,
+		location:          nil,
+		unit:              "",
+		durationUnit:      0,
+		encoding:          "",
 	})
 	if err != nil {
 		return err
@@ -75,38 +111,73 @@ func (c *ConfigSet) decode(target interface{}, options []decodeOption) error {
 		return ErrNoTargetFieldsAreSet
 	}
 
+	if decodeSet.usage == nil {
+		return nil
+	}
+
+	return decodeSet.checkUnusedKeys(decodeSettings)
+}
+
+func (c *ConfigSet) checkUnusedKeys(decodeSettings *decodeSettings) error {
+	var (
+		prefixPath = joinPath("", decodeSettings.prefix)
+		unusedKeys []string
+	)
+
+	c.Sub(decodeSettings.prefix).Walk(func(path string, _ any) {
+		if leafPath := joinPath(prefixPath, path); !c.usage.isUsed(leafPath) {
+			unusedKeys = append(unusedKeys, leafPath)
+		}
+	})
+
+	if len(unusedKeys) == 0 {
+		return nil
+	}
+
+	if decodeSettings.unusedKeysHandler != nil {
+		decodeSettings.unusedKeysHandler(unusedKeys)
+	}
+
+	if decodeSettings.disallowUnknownKeys {
+		return fmt.Errorf("%w: %s", ErrUnknownKeys, strings.Join(unusedKeys, ", "))
+	}
+
 	return nil
 }
 
-func (c *ConfigSet) getFieldConfigValue(fieldOpts fieldOptions) (any, error) {
+func (c *ConfigSet) getFieldConfigValue(fieldOpts fieldOptions) (any, bool, error) {
 	if fieldOpts.required && fieldOpts.defaultValue != nil {
-		return nil, fmt.Errorf("%w: %s", errCannotHaveDefaultForRequiredField, fieldOpts.path)
+		return nil, false, fmt.Errorf("%w: %s", errCannotHaveDefaultForRequiredField, fieldOpts.path)
 	}
 
 	configValue, err := c.getByPath(fieldOpts.path)
 	if err != nil {
 		if fieldOpts.required {
-			return nil, fmt.Errorf("field is required: %w", err)
+			return nil, false, fmt.Errorf("field is required: %w", err)
 		}
 
 		if fieldOpts.defaultValue != nil {
-			return *fieldOpts.defaultValue, nil
+			return *fieldOpts.defaultValue, false, nil
 		}
 
-		return nil, errCannotDecodeNonRequiredField
+		return nil, false, errCannotDecodeNonRequiredField
 	}
 
-	return configValue, nil
+	return configValue, true, nil
 }
 
 func (c *ConfigSet) decodeField(targetValue reflect.Value, fieldOpts fieldOptions) (int, error) {
-	fieldConfigValue, err := c.getFieldConfigValue(fieldOpts)
+	fieldOpts = c.decoder.applyDefaults(fieldOpts)
+
+	fieldConfigValue, fieldConfigValueFound, err := c.getFieldConfigValue(fieldOpts)
 	if err != nil {
 		if !errors.Is(err, errCannotDecodeNonRequiredField) {
 			return 0, err
 		}
 	}
 
+	fieldSet := c.fieldValue(fieldConfigValue, fieldOpts.path, fieldConfigValueFound)
+
 	var (
 		decodedFields int
 		decodeErr     error
@@ -114,7 +185,9 @@ func (c *ConfigSet) decodeField(targetValue reflect.Value, fieldOpts fieldOption
 	)
 
 	if commonDecoder := getCommonDecoder(targetValue.Type()); commonDecoder != nil {
-		return c.decodeCommon(commonDecoder, targetValue, fieldConfigValue, fieldOpts.strict)
+		fieldSet.markUsed()
+
+		return c.decodeCommon(commonDecoder, targetValue, fieldConfigValue, fieldOpts)
 	}
 
 	if targetValue.Kind() == reflect.Ptr {
@@ -140,6 +213,8 @@ func (c *ConfigSet) decodeField(targetValue reflect.Value, fieldOpts fieldOption
 
 	// check if targetValue implements Decoder interface
 	if decoder, ok := targetValue.Addr().Interface().(Decoder); ok {
+		fieldSet.markUsed()
+
 		if err := decoder.Decode(fieldConfigValue); err != nil {
 			return 0, fmt.Errorf("%w: %w", errCannotDecodeCustomTypeField, err)
 		}
@@ -149,16 +224,28 @@ func (c *ConfigSet) decodeField(targetValue reflect.Value, fieldOpts fieldOption
 
 	switch targetValue.Kind() {
 	case reflect.Map:
-		fieldDecoder = c.decodeMap
+		fieldDecoder = fieldSet.decodeMap
 	case reflect.Slice:
-		fieldDecoder = c.decodeSlice
+		fieldDecoder = fieldSet.decodeSlice
+	case reflect.Array:
+		fieldDecoder = fieldSet.decodeArray
+	case reflect.Interface:
+		fieldDecoder = fieldSet.decodeInterface
 	case reflect.Struct:
-		fieldDecoder = c.decodeStruct
+		if isTextUnmarshalerStruct(targetValue, fieldConfigValue) {
+			fieldSet.markUsed()
+
+			fieldDecoder = fieldSet.decodePrimitiveType
+		} else {
+			fieldDecoder = fieldSet.decodeStruct
+		}
 	default:
-		fieldDecoder = c.decodePrimitiveType
+		fieldSet.markUsed()
+
+		fieldDecoder = fieldSet.decodePrimitiveType
 	}
 
-	decodedFields, decodeErr = fieldDecoder(targetValue, fieldConfigValue, fieldOpts.strict)
+	decodedFields, decodeErr = fieldDecoder(targetValue, fieldConfigValue, fieldOpts)
 	if decodeErr != nil {
 		return 0, decodeErr
 	}
@@ -166,7 +253,7 @@ func (c *ConfigSet) decodeField(targetValue reflect.Value, fieldOpts fieldOption
 	return decodedFields, nil
 }
 
-func (c *ConfigSet) decodeCommon(commonDecoder decoderFunc, targetValue reflect.Value, fieldConfigValue any, strict bool) (int, error) {
+func (c *ConfigSet) decodeCommon(commonDecoder decoderFunc, targetValue reflect.Value, fieldConfigValue any, fieldOpts fieldOptions) (int, error) {
 	for targetValue.Kind() == reflect.Ptr {
 		if fieldConfigValue == nil {
 			return 0, nil
@@ -179,8 +266,8 @@ func (c *ConfigSet) decodeCommon(commonDecoder decoderFunc, targetValue reflect.
 		targetValue = targetValue.Elem()
 	}
 
-	if err := commonDecoder(targetValue, fieldConfigValue); err != nil {
-		if strict {
+	if err := commonDecoder(targetValue, fieldConfigValue, fieldOpts); err != nil {
+		if fieldOpts.strict {
 			return 0, fmt.Errorf("error decoding field value: %w", err)
 		}
 
@@ -190,15 +277,31 @@ func (c *ConfigSet) decodeCommon(commonDecoder decoderFunc, targetValue reflect.
 	return 1, nil
 }
 
-func (c *ConfigSet) decodeMap(targetMapValue reflect.Value, configValue any, strict bool) (int, error) {
+func (c *ConfigSet) decodeMap(targetMapValue reflect.Value, configValue any, fieldOpts fieldOptions) (int, error) {
 	var (
 		configMapValue     = reflect.ValueOf(configValue)
+		configMapValueKind = configMapValue.Kind()
 		targetMapValueType = targetMapValue.Type()
 		targetKeyType      = targetMapValueType.Key()
 		targetValueType    = targetMapValueType.Elem()
 		setFieldCount      = 0
 	)
 
+	if configMapValueKind != reflect.Map {
+		if configMapValueKind != reflect.String {
+			return 0, fmt.Errorf("%w: %v", errCannotDecodeNonMapValueToTarget, configMapValueKind)
+		}
+
+		c.markUsed()
+
+		splitMap, err := splitStringToMap(configMapValue.String(), fieldOpts.separator, fieldOpts.keyValueSeparator)
+		if err != nil {
+			return 0, err
+		}
+
+		return c.decodeMap(targetMapValue, splitMap, fieldOpts)
+	}
+
 	// setup empty map
 	targetMapValue.Set(reflect.MakeMap(targetMapValueType))
 
@@ -208,20 +311,17 @@ func (c *ConfigSet) decodeMap(targetMapValue reflect.Value, configValue any, str
 			v = reflect.New(targetValueType).Elem()
 		)
 
-		// decode map key
-		_, err := c.decodePrimitiveType(k, key.Interface(), strict)
+		// decode map key, skipping the entries with keys which can't be decoded in non-strict mode
+		decodedKeyCount, err := c.decodeMapKey(k, key.Interface(), fieldOpts.strict)
 		if err != nil {
 			return 0, fmt.Errorf("error decoding map key: %w", err)
+		} else if decodedKeyCount == 0 {
+			continue
 		}
 
-		// decode map value
-		decodedFieldCount, err := c.subValue(configValue).
-			decodeField(v, fieldOptions{
-				path:         keySegment(key.String()).String(),
-				strict:       strict,
-				required:     false,
-				defaultValue: nil,
-			})
+		// decode map value, which is looked up directly as its key may contain path separators
+		decodedFieldCount, err := c.childValue(configMapValue.MapIndex(key).Interface(), keySegment(fmt.Sprint(key.Interface()))).
+			decodeField(v, elementFieldOptions("", fieldOpts))
 		if err != nil {
 			return 0, fmt.Errorf("error decoding map value: %w", err)
 		}
@@ -234,30 +334,77 @@ func (c *ConfigSet) decodeMap(targetMapValue reflect.Value, configValue any, str
 	return setFieldCount, nil
 }
 
-func (c *ConfigSet) decodeStruct(targetStructValue reflect.Value, configValue any, strict bool) (int, error) {
+func (c *ConfigSet) decodeMapKey(targetKeyValue reflect.Value, key any, strict bool) (int, error) {
+	// map keys are decoded using the same decoders as values, but without tracking their usage
+	keySet := &ConfigSet{
+		value:   &key,
+		decoder: c.decoder,
+		path:    "",
+		usage:   nil,
+	}
+
+	return keySet.decodeField(targetKeyValue, fieldOptions{
+		path:              "",
+		skip:              false,
+		strict:            strict,
+		required:          false,
+		defaultValue:      nil,
+		separator:         "",
+		keyValueSeparator: "",
+		timeLayouts:       nil,
+		location:          nil,
+		unit:              "",
+		durationUnit:      0,
+		encoding:          "",
+	})
+}
+
+func (c *ConfigSet) decodeStruct(targetStructValue reflect.Value, configValue any, fieldOpts fieldOptions) (int, error) {
 	var (
 		targetStructType = targetStructValue.Type()
 		setFieldCount    = 0
 	)
 
+	c.markStruct()
+
 	for i := range targetStructValue.NumField() {
-		// get the struct field's tag and options
-		targetStructFieldOpts := c.readTag(targetStructType.Field(i), c.decoder.tag)
+		var (
+			// get the struct field's type, tag and options
+			targetStructField     = targetStructType.Field(i)
+			targetStructFieldOpts = c.readTag(targetStructField, c.decoder.tag)
 
-		// get the struct field's reflection value
-		targetStructFieldValue := targetStructValue.Field(i)
+			// get the struct field's reflection value
+			targetStructFieldValue = targetStructValue.Field(i)
 
-		// check if the field is exported
-		if !targetStructFieldValue.CanSet() || !targetStructFieldValue.Addr().CanInterface() {
+			decodedFieldCount int
+			err               error
+		)
+
+		if targetStructFieldOpts.skip {
 			continue
 		}
 
 		// set the field's strictness
-		targetStructFieldOpts.strict = strict || targetStructFieldOpts.strict
+		targetStructFieldOpts.strict = fieldOpts.strict || targetStructFieldOpts.strict
+
+		// decode the field, the exported fields of unexported embedded structs are decoded as well
+		switch {
+		case targetStructFieldValue.CanSet() && targetStructFieldValue.Addr().CanInterface():
+			fieldSet := c.subValue(configValue)
+
+			// fields without a path of their own resolve to the path of the struct, so only the fields of nested structs may mark its values as used
+			if targetStructFieldOpts.path == "" && !isSquashableType(targetStructField.Type) {
+				fieldSet.usage = nil
+			}
+
+			decodedFieldCount, err = fieldSet.decodeField(targetStructFieldValue, targetStructFieldOpts)
+		case targetStructField.Anonymous && targetStructField.Type.Kind() == reflect.Struct:
+			decodedFieldCount, err = c.subValue(configValue).
+				decodeEmbeddedStruct(targetStructFieldValue, targetStructFieldOpts)
+		default:
+			continue
+		}
 
-		// decode the field
-		decodedFieldCount, err := c.subValue(configValue).
-			decodeField(targetStructFieldValue, targetStructFieldOpts)
 		if err != nil {
 			return 0, fmt.Errorf("error decoding struct field value: %w", err)
 		}
@@ -268,7 +415,21 @@ func (c *ConfigSet) decodeStruct(targetStructValue reflect.Value, configValue an
 	return setFieldCount, nil
 }
 
-func (c *ConfigSet) decodeSlice(targetSliceValue reflect.Value, configValue any, strict bool) (int, error) {
+func (c *ConfigSet) decodeEmbeddedStruct(targetStructValue reflect.Value, fieldOpts fieldOptions) (int, error) {
+	fieldConfigValue, fieldConfigValueFound, err := c.getFieldConfigValue(fieldOpts)
+	if err != nil {
+		if !errors.Is(err, errCannotDecodeNonRequiredField) {
+			return 0, err
+		}
+
+		return 0, nil
+	}
+
+	return c.fieldValue(fieldConfigValue, fieldOpts.path, fieldConfigValueFound).
+		decodeStruct(targetStructValue, fieldConfigValue, fieldOpts)
+}
+
+func (c *ConfigSet) decodeSlice(targetSliceValue reflect.Value, configValue any, fieldOpts fieldOptions) (int, error) {
 	var (
 		configSliceValue     = reflect.ValueOf(configValue)
 		configSliceValueKind = configSliceValue.Kind()
@@ -280,7 +441,13 @@ func (c *ConfigSet) decodeSlice(targetSliceValue reflect.Value, configValue any,
 			return 0, fmt.Errorf("%w: %v", errCannotDecodeNonSliceValueToTarget, configSliceValueKind)
 		}
 
-		return c.decodeSlice(targetSliceValue, strings.Split(configSliceValue.String(), sliceSplitChar), strict)
+		c.markUsed()
+
+		if targetSliceValue.Type().Elem().Kind() == reflect.Uint8 && fieldOpts.encoding != "" {
+			return c.decodeByteString(targetSliceValue, configSliceValue.String(), fieldOpts)
+		}
+
+		return c.decodeSlice(targetSliceValue, splitString(configSliceValue.String(), fieldOpts.separator), fieldOpts)
 	}
 
 	configSliceValueLength := configSliceValue.Len()
@@ -291,12 +458,7 @@ func (c *ConfigSet) decodeSlice(targetSliceValue reflect.Value, configValue any,
 	// Decode each element based on its type
 	for i := range configSliceValueLength {
 		decodedFieldCount, err := c.subValue(configValue).
-			decodeField(targetSliceValue.Index(i), fieldOptions{
-				path:         indexSegment(i).String(),
-				strict:       strict,
-				required:     false,
-				defaultValue: nil,
-			})
+			decodeField(targetSliceValue.Index(i), elementFieldOptions(indexSegment(i).String(), fieldOpts))
 		if err != nil {
 			return setFieldCount, fmt.Errorf("error decoding slice element value: %w", err)
 		}
@@ -307,7 +469,155 @@ func (c *ConfigSet) decodeSlice(targetSliceValue reflect.Value, configValue any,
 	return setFieldCount, nil
 }
 
-func (c *ConfigSet) decodePrimitiveType(primitiveValue reflect.Value, configValue any, strict bool) (int, error) {
+// decodeByteString decodes strings into byte slices and arrays, using the encoding set for the field.
+func (c *ConfigSet) decodeByteString(targetValue reflect.Value, configValue string, fieldOpts fieldOptions) (int, error) {
+	decodedBytes, err := decodeBytes(configValue, fieldOpts.encoding)
+	if err != nil {
+		if fieldOpts.strict {
+			return 0, fmt.Errorf("error decoding byte string: %w", err)
+		}
+
+		return 0, nil
+	}
+
+	if targetValue.Kind() == reflect.Slice {
+		targetValue.SetBytes(decodedBytes)
+
+		return 1, nil
+	}
+
+	// In strict mode the lengths must match, otherwise the bytes are truncated or the array is padded with zeros
+	if fieldOpts.strict && len(decodedBytes) != targetValue.Len() {
+		return 0, fmt.Errorf("%w: %d != %d", errArrayLengthMismatch, len(decodedBytes), targetValue.Len())
+	}
+
+	targetValue.SetZero()
+	reflect.Copy(targetValue, reflect.ValueOf(decodedBytes))
+
+	return 1, nil
+}
+
+func (c *ConfigSet) decodeArray(targetArrayValue reflect.Value, configValue any, fieldOpts fieldOptions) (int, error) {
+	var (
+		configSliceValue     = reflect.ValueOf(configValue)
+		configSliceValueKind = configSliceValue.Kind()
+		setFieldCount        = 0
+	)
+
+	if configSliceValueKind != reflect.Slice {
+		if configSliceValueKind != reflect.String {
+			return 0, fmt.Errorf("%w: %v", errCannotDecodeNonSliceValueToTarget, configSliceValueKind)
+		}
+
+		c.markUsed()
+
+		if targetArrayValue.Type().Elem().Kind() == reflect.Uint8 && fieldOpts.encoding != "" {
+			return c.decodeByteString(targetArrayValue, configSliceValue.String(), fieldOpts)
+		}
+
+		return c.decodeArray(targetArrayValue, splitString(configSliceValue.String(), fieldOpts.separator), fieldOpts)
+	}
+
+	var (
+		configSliceValueLength = configSliceValue.Len()
+		targetArrayValueLength = targetArrayValue.Len()
+	)
+
+	// In strict mode the lengths must match, otherwise the config slice is truncated or the array is padded with zero values
+	if fieldOpts.strict && configSliceValueLength != targetArrayValueLength {
+		return 0, fmt.Errorf("%w: %d != %d", errArrayLengthMismatch, configSliceValueLength, targetArrayValueLength)
+	}
+
+	targetArrayValue.SetZero()
+
+	// Decode each element based on its type
+	for i := range min(configSliceValueLength, targetArrayValueLength) {
+		decodedFieldCount, err := c.subValue(configValue).
+			decodeField(targetArrayValue.Index(i), elementFieldOptions(indexSegment(i).String(), fieldOpts))
+		if err != nil {
+			return setFieldCount, fmt.Errorf("error decoding array element value: %w", err)
+		}
+
+		setFieldCount += decodedFieldCount
+	}
+
+	return setFieldCount, nil
+}
+
+func (c *ConfigSet) decodeInterface(targetInterfaceValue reflect.Value, configValue any, fieldOpts fieldOptions) (int, error) {
+	targetInterfaceType := targetInterfaceValue.Type()
+
+	if polymorphicType, ok := c.decoder.polymorphicTypes[targetInterfaceType]; ok {
+		return c.decodePolymorphicType(targetInterfaceValue, polymorphicType, fieldOpts.strict)
+	}
+
+	if targetInterfaceType.NumMethod() != 0 {
+		return 0, fmt.Errorf("%w: %v", errUnregisteredInterfaceType, targetInterfaceType)
+	}
+
+	if configValue == nil {
+		return 0, nil
+	}
+
+	c.markUsed()
+
+	// copy the raw subtree, so later changes to the config set won't affect the decoded value
+	targetInterfaceValue.Set(reflect.ValueOf(copyValue(configValue)))
+
+	return 1, nil
+}
+
+func (c *ConfigSet) decodePolymorphicType(targetInterfaceValue reflect.Value, polymorphicType polymorphicType, strict bool) (int, error) {
+	if *c.value == nil {
+		return 0, nil
+	}
+
+	discriminatorValue, err := c.getByPath(polymorphicType.discriminatorKey)
+	if err != nil {
+		if strict {
+			return 0, fmt.Errorf("%w: %s: %w", errMissingDiscriminator, polymorphicType.discriminatorKey, err)
+		}
+
+		return 0, nil
+	}
+
+	c.fieldValue(discriminatorValue, polymorphicType.discriminatorKey, true).markUsed()
+
+	implementationType, ok := polymorphicType.implementations[castToString(discriminatorValue)]
+	if !ok {
+		if strict {
+			return 0, fmt.Errorf("%w: %v", errUnknownDiscriminator, discriminatorValue)
+		}
+
+		return 0, nil
+	}
+
+	implementationValue := reflect.New(implementationType).Elem()
+
+	decodedFieldCount, err := c.decodeField(implementationValue, fieldOptions{
+		path:              "",
+		skip:              false,
+		strict:            strict,
+		required:          false,
+		defaultValue:      nil,
+		separator:         "",
+		keyValueSeparator: "",
+		timeLayouts:       nil,
+		location:          nil,
+		unit:              "",
+		durationUnit:      0,
+		encoding:          "",
+	})
+	if err != nil {
+		return 0, fmt.Errorf("error decoding %v implementation: %w", implementationType, err)
+	}
+
+	targetInterfaceValue.Set(implementationValue)
+
+	return decodedFieldCount, nil
+}
+
+func (c *ConfigSet) decodePrimitiveType(primitiveValue reflect.Value, configValue any, fieldOpts fieldOptions) (int, error) {
 	primitiveInterface := primitiveValue.Addr().Interface()
 
 	// check if primitive implements encoding.TextUnmarshaler interface
@@ -329,21 +639,22 @@ func (c *ConfigSet) decodePrimitiveType(primitiveValue reflect.Value, configValu
 
 	switch primitiveValueKind {
 	case reflect.Bool:
-		primitiveDecoderFunc = decodeBool
+		primitiveDecoderFunc = c.decoder.decodeBool
 	case reflect.String:
 		primitiveDecoderFunc = decodeString
 	case reflect.Float32, reflect.Float64:
 		primitiveDecoderFunc = decodeFloat
-	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
-		primitiveDecoderFunc = decodeInt
-	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
-		primitiveDecoderFunc = decodeUint
+	case reflect.Complex64, reflect.Complex128:
+		primitiveDecoderFunc = decodeComplex
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		primitiveDecoderFunc = getIntegerDecoder(primitiveValueKind, fieldOpts)
 	default:
 		return 0, fmt.Errorf("%w: %v", errUnsupportedPrimitiveKind, primitiveValueKind)
 	}
 
-	if err := primitiveDecoderFunc(primitiveValue, configValue); err != nil {
-		if strict {
+	if err := primitiveDecoderFunc(primitiveValue, configValue, fieldOpts); err != nil {
+		if fieldOpts.strict {
 			return 0, fmt.Errorf("error decoding primitive value: %w", err)
 		}
 
@@ -355,23 +666,90 @@ func (c *ConfigSet) decodePrimitiveType(primitiveValue reflect.Value, configValu
 
 func (c *ConfigSet) readTag(field reflect.StructField, tag string) fieldOptions {
 	fieldOpts := fieldOptions{
-		path:         "",
-		strict:       false,
-		required:     false,
-		defaultValue: nil,
+		path:              "",
+		skip:              false,
+		strict:            false,
+		required:          false,
+		defaultValue:      nil,
+		separator:         "",
+		keyValueSeparator: "",
+		timeLayouts:       nil,
+		location:          nil,
+		unit:              "",
+		durationUnit:      0,
+		encoding:          "",
 	}
 
 	tagValue := field.Tag.Get(tag)
-	if tagValue == "" {
+	if tagValue == skipFieldTag {
+		fieldOpts.skip = true
+
 		return fieldOpts
 	}
 
-	tagParts := strings.Split(tagValue, ",")
+	var (
+		tagParts = strings.Split(tagValue, tagOptionSeparator)
+		prefix   = ""
+		// embedded structs are flattened into their parent by default
+		squash = field.Anonymous && isSquashableType(field.Type)
+	)
 
 	fieldOpts.path = tagParts[0]
 
 	// read the remaining tag parts
-	for _, part := range tagParts[1:] {
+	for i := 1; i < len(tagParts); i++ {
+		part := tagParts[i]
+
+		// an empty separator option is followed by an empty part if the separator is the tag option separator itself
+		if (part == "sep=" || part == "kvsep=") && i+1 < len(tagParts) && tagParts[i+1] == "" {
+			part += tagOptionSeparator
+			i++
+		}
+
+		if strings.HasPrefix(part, "sep=") {
+			fieldOpts.separator = part[4:]
+
+			continue
+		}
+
+		if strings.HasPrefix(part, "layout=") {
+			fieldOpts.timeLayouts = []string{part[7:]}
+
+			continue
+		}
+
+		if strings.HasPrefix(part, "encoding=") {
+			fieldOpts.encoding = part[9:]
+
+			continue
+		}
+
+		if strings.HasPrefix(part, "unit=") {
+			fieldOpts.unit = part[5:]
+
+			continue
+		}
+
+		if strings.HasPrefix(part, "kvsep=") {
+			fieldOpts.keyValueSeparator = part[6:]
+
+			continue
+		}
+
+		// squashed fields are flattened into their parent, so the path of the tag is ignored
+		if part == "squash" || part == "inline" {
+			squash = true
+			fieldOpts.path = ""
+
+			continue
+		}
+
+		if strings.HasPrefix(part, "prefix=") {
+			prefix = part[7:]
+
+			continue
+		}
+
 		if part == "strict" {
 			fieldOpts.strict = true
 
@@ -392,13 +770,175 @@ func (c *ConfigSet) readTag(field reflect.StructField, tag string) fieldOptions
 		}
 	}
 
+	// nest the field under the prefix, or derive its path from its name unless it is squashed into its parent
+	if prefix != "" {
+		fieldOpts.path = joinPath(fieldOpts.path, prefix)
+	} else if fieldOpts.path == "" && !squash && c.decoder.namingStrategy != nil {
+		fieldOpts.path = c.decoder.namingStrategy(field.Name)
+	}
+
 	return fieldOpts
 }
 
+func isSquashableType(fieldType reflect.Type) bool {
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+
+	return fieldType.Kind() == reflect.Struct && getCommonDecoder(fieldType) == nil
+}
+
 func (c *ConfigSet) subValue(value any) *ConfigSet {
 	return &ConfigSet{
 		value:   &value,
 		decoder: c.decoder,
+		path:    c.path,
+		usage:   c.usage,
+	}
+}
+
+func (c *ConfigSet) childValue(value any, childSegment segment) *ConfigSet {
+	childSet := c.subValue(value)
+	childSet.path = appendSegment(c.path, childSegment)
+
+	return childSet
+}
+
+func (c *ConfigSet) fieldValue(value any, path string, tracked bool) *ConfigSet {
+	fieldSet := c.subValue(value)
+	fieldSet.path = joinPath(c.path, path)
+
+	if !tracked {
+		fieldSet.usage = nil
+	}
+
+	return fieldSet
+}
+
+// elementFieldOptions returns the options of the elements of slices and arrays and the values of maps at the given path,
+// which inherit the strictness and the value format options of their field, such as its time layouts, units and encoding.
+func elementFieldOptions(path string, fieldOpts fieldOptions) fieldOptions {
+	return fieldOptions{
+		path:              path,
+		skip:              false,
+		strict:            fieldOpts.strict,
+		required:          false,
+		defaultValue:      nil,
+		separator:         "",
+		keyValueSeparator: "",
+		timeLayouts:       fieldOpts.timeLayouts,
+		location:          fieldOpts.location,
+		unit:              fieldOpts.unit,
+		durationUnit:      fieldOpts.durationUnit,
+		encoding:          fieldOpts.encoding,
+	}
+}
+
+// applyDefaults sets the options which weren't set for the field to the defaults of the decoder.
+func (d *decoder) applyDefaults(fieldOpts fieldOptions) fieldOptions {
+	if fieldOpts.separator == "" {
+		fieldOpts.separator = d.sliceSeparator
+	}
+
+	if fieldOpts.keyValueSeparator == "" {
+		fieldOpts.keyValueSeparator = d.keyValueSeparator
+	}
+
+	if fieldOpts.timeLayouts == nil {
+		fieldOpts.timeLayouts = d.timeLayouts
+	}
+
+	if fieldOpts.location == nil {
+		fieldOpts.location = d.location
+	}
+
+	if fieldOpts.durationUnit == 0 {
+		fieldOpts.durationUnit = d.durationUnit
+	}
+
+	return fieldOpts
+}
+
+// getIntegerDecoder returns the decoder of integer fields, which are decoded from byte sizes if their unit is set to bytes.
+func getIntegerDecoder(kind reflect.Kind, fieldOpts fieldOptions) decoderFunc {
+	switch {
+	case fieldOpts.unit == byteSizeUnit:
+		return decodeByteSize
+	case kind >= reflect.Uint && kind <= reflect.Uint64:
+		return decodeUint
+	default:
+		return decodeInt
+	}
+}
+
+// isTextUnmarshalerStruct reports whether the struct should be decoded as text from a scalar config value.
+func isTextUnmarshalerStruct(targetValue reflect.Value, configValue any) bool {
+	if _, ok := targetValue.Addr().Interface().(encoding.TextUnmarshaler); !ok || configValue == nil {
+		return false
+	}
+
+	return reflect.ValueOf(configValue).Kind() != reflect.Map
+}
+
+// splitString splits the string at the separator, trimming the whitespace around the elements and skipping the empty ones.
+func splitString(value, separator string) []string {
+	elements := make([]string, 0, strings.Count(value, separator)+1)
+
+	for _, element := range strings.Split(value, separator) {
+		if element = strings.TrimSpace(element); element != "" {
+			elements = append(elements, element)
+		}
+	}
+
+	return elements
+}
+
+// splitStringToMap splits the string into key-value pairs at the separator, then each pair into its key and value at the key-value separator.
+// Without a key-value separator, the pairs are split at the first colon or equals sign, so the value of the pair keeps any further ones,
+// e.g. a=1,b=2 is split into the key a and the value 1,b=2 unless the separator is a comma.
+func splitStringToMap(value, separator, keyValueSeparator string) (map[string]any, error) {
+	splitMap := make(map[string]any)
+
+	for _, element := range splitString(value, separator) {
+		var keyValueSeparatorIndex, keyValueSeparatorLength int
+
+		if keyValueSeparator == "" {
+			keyValueSeparatorIndex, keyValueSeparatorLength = strings.IndexAny(element, defaultKeyValueSeparators), 1
+		} else {
+			keyValueSeparatorIndex, keyValueSeparatorLength = strings.Index(element, keyValueSeparator), len(keyValueSeparator)
+		}
+
+		if keyValueSeparatorIndex == -1 {
+			return nil, fmt.Errorf("%w: %s", errInvalidKeyValuePair, element)
+		}
+
+		key := strings.TrimSpace(element[:keyValueSeparatorIndex])
+		splitMap[key] = strings.TrimSpace(element[keyValueSeparatorIndex+keyValueSeparatorLength:])
+	}
+
+	return splitMap, nil
+}
+
+func copyValue(value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		mapCopy := make(map[string]any, len(v))
+
+		for key, element := range v {
+			mapCopy[key] = copyValue(element)
+		}
+
+		return mapCopy
+	case []any:
+		sliceCopy := make([]any, len(v))
+
+		for i, element := range v {
+			sliceCopy[i] = copyValue(element)
+		}
+
+		return sliceCopy
+	default:
+		return value
 	}
 }
 
@@ -3,8 +3,10 @@ package confiq_test
 import (
 	"errors"
 	"fmt"
+	"net/netip"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/greencoda/confiq"
 	"github.com/greencoda/confiq/mocks"
@@ -85,7 +87,7 @@ func (s *DecodeTestSuite) Test_Decode_UnsupportedPrimitive() {
 	s.Require().NoError(loadErr)
 
 	type targetStruct struct {
-		TestComplex complex64 `cfg:"test_int"`
+		TestChannel chan int `cfg:"test_int"`
 	}
 
 	var target targetStruct
@@ -633,3 +635,787 @@ func (s *DecodeTestSuite) Test_Decode_EmptyTag() {
 	s.Equal(expected, target.TestStruct.TestString)
 	s.NoError(decodeErr)
 }
+
+type TLSConfig struct {
+	CertFile string `cfg:"cert_file"`
+	KeyFile  string `cfg:"key_file"`
+}
+
+type RetryConfig struct {
+	MaxRetries int `cfg:"max_retries"`
+}
+
+type timeoutConfig struct {
+	Timeout string `cfg:"timeout"`
+}
+
+func (s *DecodeTestSuite) Test_Decode_EmbeddedStruct() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"host":        "localhost",
+		"cert_file":   "cert.pem",
+		"key_file":    "key.pem",
+		"max_retries": 3,
+		"timeout":     "5s",
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TLSConfig
+		*RetryConfig
+		timeoutConfig
+
+		Host string `cfg:"host"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target, confiq.DisallowUnknownKeys())
+
+	s.Equal("localhost", target.Host)
+	s.Equal(TLSConfig{CertFile: "cert.pem", KeyFile: "key.pem"}, target.TLSConfig)
+	s.Require().NotNil(target.RetryConfig)
+	s.Equal(3, target.MaxRetries)
+	s.Equal("5s", target.Timeout)
+	s.NoError(decodeErr)
+}
+
+func (s *DecodeTestSuite) Test_Decode_EmbeddedStruct_WithNamingStrategy() {
+	configSet := confiq.New(
+		confiq.WithNamingStrategy(confiq.SnakeCase),
+	)
+
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"host":      "localhost",
+		"cert_file": "cert.pem",
+		"retry": map[string]any{
+			"max_retries": 3,
+		},
+	}})
+
+	loadErr := configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TLSConfig
+
+		Retry RetryConfig
+		Host  string
+	}
+
+	var target targetStruct
+
+	decodeErr := configSet.Decode(&target)
+
+	s.Equal("localhost", target.Host)
+	s.Equal("cert.pem", target.CertFile)
+	s.Equal(3, target.Retry.MaxRetries)
+	s.NoError(decodeErr)
+}
+
+func (s *DecodeTestSuite) Test_Decode_SquashedStruct() {
+	configSet := confiq.New(
+		confiq.WithNamingStrategy(confiq.SnakeCase),
+	)
+
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"cert_file":   "cert.pem",
+		"max_retries": 3,
+	}})
+
+	loadErr := configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TLS   TLSConfig   `cfg:",squash"`
+		Retry RetryConfig `cfg:",inline"`
+	}
+
+	var target targetStruct
+
+	decodeErr := configSet.Decode(&target)
+
+	s.Equal("cert.pem", target.TLS.CertFile)
+	s.Equal(3, target.Retry.MaxRetries)
+	s.NoError(decodeErr)
+}
+
+func (s *DecodeTestSuite) Test_Decode_SquashedStruct_WithPath() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"cert_file": "cert.pem",
+		"tls": map[string]any{
+			"cert_file": "nested.pem",
+		},
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TLS TLSConfig `cfg:"tls,squash"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal("cert.pem", target.TLS.CertFile)
+	s.NoError(decodeErr)
+}
+
+func (s *DecodeTestSuite) Test_Decode_PrefixedEmbeddedStruct() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"host": "localhost",
+		"tls": map[string]any{
+			"cert_file": "cert.pem",
+		},
+		"retry": map[string]any{
+			"policy": map[string]any{
+				"max_retries": 3,
+			},
+		},
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TLSConfig   `cfg:",prefix=tls"`
+		RetryConfig `cfg:"retry,prefix=policy"`
+
+		Host string `cfg:"host"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target, confiq.DisallowUnknownKeys())
+
+	s.Equal("localhost", target.Host)
+	s.Equal("cert.pem", target.CertFile)
+	s.Equal(3, target.MaxRetries)
+	s.NoError(decodeErr)
+}
+
+func (s *DecodeTestSuite) Test_Decode_Array() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{
+		map[string]any{
+			"test_slice": []any{
+				"uno",
+				"dos",
+				"tres",
+			},
+		},
+	})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestArray [3]string `cfg:"test_slice,strict"`
+	}
+
+	var (
+		target   targetStruct
+		expected = [3]string{
+			"uno",
+			"dos",
+			"tres",
+		}
+	)
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(expected, target.TestArray)
+	s.NoError(decodeErr)
+}
+
+func (s *DecodeTestSuite) Test_Decode_StringAsArray() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"TEST_INTS": "1;2;3;4"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestInts [4]int `cfg:"TEST_INTS"`
+	}
+
+	var (
+		target   targetStruct
+		expected = [4]int{1, 2, 3, 4}
+	)
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(expected, target.TestInts)
+	s.NoError(decodeErr)
+}
+
+func (s *DecodeTestSuite) Test_Decode_Array_Truncated() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_slice": []any{"uno", "dos", "tres"}}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestArray [2]string `cfg:"test_slice"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal([2]string{"uno", "dos"}, target.TestArray)
+	s.NoError(decodeErr)
+}
+
+func (s *DecodeTestSuite) Test_Decode_Array_Padded() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_slice": []any{"uno", "dos"}}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestArray [3]string `cfg:"test_slice"`
+	}
+
+	target := targetStruct{
+		TestArray: [3]string{"", "", "stale"},
+	}
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal([3]string{"uno", "dos", ""}, target.TestArray)
+	s.NoError(decodeErr)
+}
+
+func (s *DecodeTestSuite) Test_Decode_Array_LengthMismatch() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_slice": []any{"uno", "dos"}}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestArray [3]string `cfg:"test_slice,strict"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *DecodeTestSuite) Test_Decode_Array_FromInvalidFormat() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_bool": true}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestArray [3]string `cfg:"test_bool"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+type Storage interface {
+	Location() string
+}
+
+type S3Storage struct {
+	Bucket string `cfg:"bucket"`
+}
+
+func (s S3Storage) Location() string {
+	return "s3://" + s.Bucket
+}
+
+type DiskStorage struct {
+	Path string `cfg:"path"`
+}
+
+func (d *DiskStorage) Location() string {
+	return "file://" + d.Path
+}
+
+func (s *DecodeTestSuite) Test_Decode_Any() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_section": map[string]any{
+			"test_string":       "efes",
+			"test_string_array": []any{"aleph", "beth", "gimel"},
+		},
+		"test_values": []any{1, "two", 3.0},
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestAny     any   `cfg:"test_section"`
+		TestValues  []any `cfg:"test_values"`
+		TestMissing any   `cfg:"test_missing"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+	s.Require().NoError(decodeErr)
+
+	setErr := s.configSet.Set("test_section.test_string", "overridden")
+	s.Require().NoError(setErr)
+
+	s.Equal(map[string]any{
+		"test_string":       "efes",
+		"test_string_array": []any{"aleph", "beth", "gimel"},
+	}, target.TestAny)
+	s.Equal([]any{1, "two", 3.0}, target.TestValues)
+	s.Nil(target.TestMissing)
+}
+
+func (s *DecodeTestSuite) Test_Decode_PolymorphicType() {
+	configSet := confiq.New(
+		confiq.WithPolymorphicType[Storage]("type", map[string]Storage{
+			"s3":   S3Storage{},
+			"disk": &DiskStorage{},
+		}),
+	)
+
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"primary": map[string]any{
+			"type":   "s3",
+			"bucket": "backups",
+		},
+		"secondary": map[string]any{
+			"type": "disk",
+			"path": "/var/backups",
+		},
+	}})
+
+	loadErr := configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		Primary   Storage `cfg:"primary"`
+		Secondary Storage `cfg:"secondary"`
+		Tertiary  Storage `cfg:"tertiary"`
+	}
+
+	var target targetStruct
+
+	decodeErr := configSet.Decode(&target, confiq.DisallowUnknownKeys())
+
+	s.Equal(S3Storage{Bucket: "backups"}, target.Primary)
+	s.Equal(&DiskStorage{Path: "/var/backups"}, target.Secondary)
+	s.Nil(target.Tertiary)
+	s.NoError(decodeErr)
+}
+
+func (s *DecodeTestSuite) Test_Decode_PolymorphicType_UnknownDiscriminator() {
+	configSet := confiq.New(
+		confiq.WithPolymorphicType[Storage]("type", map[string]Storage{
+			"s3": S3Storage{},
+		}),
+	)
+
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"primary": map[string]any{
+			"type": "tape",
+		},
+	}})
+
+	loadErr := configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		Primary Storage `cfg:"primary,strict"`
+	}
+
+	var target targetStruct
+
+	decodeErr := configSet.Decode(&target)
+
+	s.Nil(target.Primary)
+	s.Error(decodeErr)
+}
+
+func (s *DecodeTestSuite) Test_Decode_PolymorphicType_MissingDiscriminator() {
+	configSet := confiq.New(
+		confiq.WithPolymorphicType[Storage]("type", map[string]Storage{
+			"s3": S3Storage{},
+		}),
+	)
+
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"primary": map[string]any{
+			"bucket": "backups",
+		},
+	}})
+
+	loadErr := configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		Primary Storage `cfg:"primary,strict"`
+	}
+
+	var target targetStruct
+
+	decodeErr := configSet.Decode(&target)
+
+	s.Nil(target.Primary)
+	s.Error(decodeErr)
+}
+
+func (s *DecodeTestSuite) Test_Decode_UnregisteredInterface() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"primary": map[string]any{
+			"type": "s3",
+		},
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		Primary Storage `cfg:"primary"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *DecodeTestSuite) Test_Decode_StringAsSlice_TrimsElements() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"TEST_STRINGS": " test1 ;test2;; test3;"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestStrings []string `cfg:"TEST_STRINGS"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal([]string{"test1", "test2", "test3"}, target.TestStrings)
+	s.NoError(decodeErr)
+}
+
+func (s *DecodeTestSuite) Test_Decode_StringAsSlice_WithSliceSeparator() {
+	configSet := confiq.New(
+		confiq.WithSliceSeparator(","),
+	)
+
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"TEST_STRINGS": "test;1, test;2"}})
+
+	loadErr := configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestStrings []string `cfg:"TEST_STRINGS"`
+	}
+
+	var target targetStruct
+
+	decodeErr := configSet.Decode(&target)
+
+	s.Equal([]string{"test;1", "test;2"}, target.TestStrings)
+	s.NoError(decodeErr)
+}
+
+func (s *DecodeTestSuite) Test_Decode_StringAsSlice_WithSeparatorTag() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"TEST_STRINGS": "test1,test2",
+		"TEST_INTS":    "1|2|3",
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestStrings []string `cfg:"TEST_STRINGS,sep=,,strict"`
+		TestInts    [3]int   `cfg:"TEST_INTS,sep=|"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal([]string{"test1", "test2"}, target.TestStrings)
+	s.Equal([3]int{1, 2, 3}, target.TestInts)
+	s.NoError(decodeErr)
+}
+
+func (s *DecodeTestSuite) Test_Decode_StringAsMap() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"TEST_MAP":       "a:1; b:2",
+		"TEST_CUSTOMMAP": "a=1,b=2",
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestMap       map[string]int `cfg:"TEST_MAP"`
+		TestCustomMap map[string]int `cfg:"TEST_CUSTOMMAP,sep=,,kvsep=="`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(map[string]int{"a": 1, "b": 2}, target.TestMap)
+	s.Equal(map[string]int{"a": 1, "b": 2}, target.TestCustomMap)
+	s.NoError(decodeErr)
+}
+
+func (s *DecodeTestSuite) Test_Decode_StringAsMap_WithKeyValueSeparator() {
+	configSet := confiq.New(
+		confiq.WithSliceSeparator(","),
+		confiq.WithKeyValueSeparator("="),
+	)
+
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"TEST_MAP": "a=1,b=2"}})
+
+	loadErr := configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestMap map[string]int `cfg:"TEST_MAP"`
+	}
+
+	var target targetStruct
+
+	decodeErr := configSet.Decode(&target)
+
+	s.Equal(map[string]int{"a": 1, "b": 2}, target.TestMap)
+	s.NoError(decodeErr)
+}
+
+func (s *DecodeTestSuite) Test_Decode_StringAsMap_FromInvalidFormat() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"TEST_MAP": "a:1;b"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestMap map[string]int `cfg:"TEST_MAP"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *DecodeTestSuite) Test_Decode_Map_FromNonMap() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_bool": true}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestMap map[string]int `cfg:"test_bool"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *DecodeTestSuite) Test_Decode_Map_WithCommonKeys() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{
+		map[string]any{
+			"test_retries": map[string]any{
+				"1s":  1,
+				"15s": 2,
+			},
+			"test_hosts": map[string]any{
+				"127.0.0.1": "localhost",
+				"::1":       "localhost6",
+			},
+			"test_labels": map[string]any{
+				"aleph": 1,
+			},
+		},
+	})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestRetries map[time.Duration]int         `cfg:"test_retries,strict"`
+		TestHosts   map[netip.Addr]string         `cfg:"test_hosts,strict"`
+		TestLabels  map[unmarshalerString]float64 `cfg:"test_labels,strict"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(map[time.Duration]int{
+		time.Second:      1,
+		15 * time.Second: 2,
+	}, target.TestRetries)
+	s.Equal(map[netip.Addr]string{
+		netip.MustParseAddr("127.0.0.1"): "localhost",
+		netip.MustParseAddr("::1"):       "localhost6",
+	}, target.TestHosts)
+	s.Equal(map[unmarshalerString]float64{
+		"aleph": 1,
+	}, target.TestLabels)
+	s.NoError(decodeErr)
+}
+
+func (s *DecodeTestSuite) Test_Decode_Map_SkipsInvalidKeys() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{
+		map[string]any{
+			"test_map": map[string]any{
+				"1":      "uno",
+				"dos":    "dos",
+				"3":      "tres",
+				"cuatro": "cuatro",
+			},
+		},
+	})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestMap map[int]string `cfg:"test_map"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(map[int]string{1: "uno", 3: "tres"}, target.TestMap)
+	s.NoError(decodeErr)
+}
+
+func (s *DecodeTestSuite) Test_Decode_StringAsMap_WithEqualsSign() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"TEST_ENDPOINTS": "api=http://localhost:8080,web=http://localhost"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestEndpoints map[string]string `cfg:"TEST_ENDPOINTS,sep=,"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(map[string]string{
+		"api": "http://localhost:8080",
+		"web": "http://localhost",
+	}, target.TestEndpoints)
+	s.NoError(decodeErr)
+}
+
+func (s *DecodeTestSuite) Test_Decode_StringAsMap_WithCommasWithoutSeparator() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"TEST_MAP": "a=1,b=2"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type stringTargetStruct struct {
+		TestMap map[string]string `cfg:"TEST_MAP"`
+	}
+
+	type intTargetStruct struct {
+		TestMap map[string]int `cfg:"TEST_MAP,strict"`
+	}
+
+	var (
+		stringTarget stringTargetStruct
+		intTarget    intTargetStruct
+	)
+
+	s.Require().NoError(s.configSet.Decode(&stringTarget))
+	s.Equal(map[string]string{"a": "1,b=2"}, stringTarget.TestMap)
+
+	s.Error(s.configSet.Decode(&intTarget))
+}
+
+func (s *DecodeTestSuite) Test_Decode_StringAsMap_WithCommonKeys() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"TEST_TIMEOUTS": "1s=low;1m=high"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestTimeouts map[time.Duration]string `cfg:"TEST_TIMEOUTS"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(map[time.Duration]string{
+		time.Second: "low",
+		time.Minute: "high",
+	}, target.TestTimeouts)
+	s.NoError(decodeErr)
+}
+
+func (s *DecodeTestSuite) Test_Decode_TextUnmarshalableStruct() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_addr": "192.168.0.1"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestAddr netip.Addr `cfg:"test_addr"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(netip.MustParseAddr("192.168.0.1"), target.TestAddr)
+	s.NoError(decodeErr)
+}
@@ -0,0 +1,93 @@
+package confiq
+
+import (
+	"encoding"
+	"reflect"
+	"slices"
+)
+
+// Field describes a struct field which is decoded from a single value of the config set.
+type Field struct {
+	// Path is the path of the value from which the field is decoded, in canonical selector syntax.
+	Path string
+	// DefaultValue is the default value set in the struct tag of the field, or nil if the field has no default value.
+	DefaultValue *string
+	// Required reports whether the field is marked as required in its struct tag.
+	Required bool
+	// Type is the type of the field.
+	Type reflect.Type
+}
+
+var (
+	decoderType         = reflect.TypeFor[Decoder]()
+	textUnmarshalerType = reflect.TypeFor[encoding.TextUnmarshaler]()
+)
+
+// Fields returns the fields of the target struct which would be decoded by the config set, in the order of their declaration.
+// Nested structs are traversed, while the fields which are decoded from a single value, such as primitives, slices, maps,
+// and types with their own decoders are returned with their paths, following the tags and the naming strategy of the config set.
+// Fields which don't have a path are omitted.
+func (c *ConfigSet) Fields(target any) ([]Field, error) {
+	targetType := reflect.TypeOf(target)
+	if targetType == nil || targetType.Kind() != reflect.Ptr || targetType.Elem().Kind() != reflect.Struct {
+		return nil, ErrInvalidTarget
+	}
+
+	return c.collectFields(targetType.Elem(), "", nil), nil
+}
+
+func (c *ConfigSet) collectFields(structType reflect.Type, path string, parentTypes []reflect.Type) []Field {
+	var fields []Field
+
+	// recursive struct types are only traversed once on each branch
+	parentTypes = append(parentTypes, structType)
+
+	for i := range structType.NumField() {
+		var (
+			structField = structType.Field(i)
+			fieldOpts   = c.readTag(structField, c.decoder.tag)
+			fieldPath   = joinPath(path, fieldOpts.path)
+			fieldType   = structField.Type
+		)
+
+		if fieldOpts.skip || (!structField.IsExported() && !(structField.Anonymous && fieldType.Kind() == reflect.Struct)) {
+			continue
+		}
+
+		if fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+
+		if isNestedStructType(fieldType) {
+			if !slices.Contains(parentTypes, fieldType) {
+				fields = append(fields, c.collectFields(fieldType, fieldPath, parentTypes)...)
+			}
+
+			continue
+		}
+
+		if fieldOpts.path == "" {
+			continue
+		}
+
+		fields = append(fields, Field{
+			Path:         fieldPath,
+			DefaultValue: fieldOpts.defaultValue,
+			Required:     fieldOpts.required,
+			Type:         structField.Type,
+		})
+	}
+
+	return fields
+}
+
+// isNestedStructType reports whether the fields of the struct type are decoded individually.
+func isNestedStructType(fieldType reflect.Type) bool {
+	if fieldType.Kind() != reflect.Struct || getCommonDecoder(fieldType) != nil {
+		return false
+	}
+
+	pointerType := reflect.PointerTo(fieldType)
+
+	return !pointerType.Implements(decoderType) && !pointerType.Implements(textUnmarshalerType)
+}
@@ -0,0 +1,90 @@
+package confiq_test
+
+import (
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/greencoda/confiq"
+	"github.com/stretchr/testify/suite"
+)
+
+type FieldsTestSuite struct {
+	suite.Suite
+}
+
+func Test_FieldsTestSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(FieldsTestSuite))
+}
+
+type fieldsTestEmbedded struct {
+	Embedded string `cfg:"embedded"`
+}
+
+type fieldsTestNode struct {
+	Name string          `cfg:"name"`
+	Next *fieldsTestNode `cfg:"next"`
+}
+
+func (s *FieldsTestSuite) Test_Fields() {
+	type Config struct {
+		fieldsTestEmbedded
+
+		Host     *url.URL `cfg:"host,required"`
+		Settings struct {
+			Timeout time.Duration `cfg:"timeout,default=5s"`
+			Tags    []string      `cfg:"tags"`
+		} `cfg:"settings"`
+		Servers  []struct{} `cfg:"servers"`
+		APIKey   string     `cfg:"apiKeys[1]"`
+		Prefixed struct {
+			Value int `cfg:"value"`
+		} `cfg:",prefix=prefixed"`
+		Node     fieldsTestNode `cfg:"node"`
+		Untagged string
+		Skipped  string `cfg:"-"`
+		private  string `cfg:"private"`
+	}
+
+	fields, err := confiq.New().Fields(&Config{})
+	s.Require().NoError(err)
+
+	defaultTimeout := "5s"
+
+	s.Equal([]confiq.Field{
+		{Path: "embedded", DefaultValue: nil, Required: false, Type: reflect.TypeFor[string]()},
+		{Path: "host", DefaultValue: nil, Required: true, Type: reflect.TypeFor[*url.URL]()},
+		{Path: "settings.timeout", DefaultValue: &defaultTimeout, Required: false, Type: reflect.TypeFor[time.Duration]()},
+		{Path: "settings.tags", DefaultValue: nil, Required: false, Type: reflect.TypeFor[[]string]()},
+		{Path: "servers", DefaultValue: nil, Required: false, Type: reflect.TypeFor[[]struct{}]()},
+		{Path: "apiKeys[1]", DefaultValue: nil, Required: false, Type: reflect.TypeFor[string]()},
+		{Path: "prefixed.value", DefaultValue: nil, Required: false, Type: reflect.TypeFor[int]()},
+		{Path: "node.name", DefaultValue: nil, Required: false, Type: reflect.TypeFor[string]()},
+	}, fields)
+}
+
+func (s *FieldsTestSuite) Test_Fields_WithNamingStrategy() {
+	type Config struct {
+		MaxConnections int
+		ServerHost     string `cfg:"host"`
+	}
+
+	fields, err := confiq.New(confiq.WithNamingStrategy(confiq.SnakeCase)).Fields(&Config{})
+	s.Require().NoError(err)
+
+	s.Require().Len(fields, 2)
+	s.Equal("max_connections", fields[0].Path)
+	s.Equal("host", fields[1].Path)
+}
+
+func (s *FieldsTestSuite) Test_Fields_InvalidTarget() {
+	for _, target := range []any{nil, struct{}{}, new(int), new([]string)} {
+		fields, err := confiq.New().Fields(target)
+
+		s.Nil(fields)
+		s.ErrorIs(err, confiq.ErrInvalidTarget)
+	}
+}
@@ -0,0 +1,137 @@
+package confiq
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+var (
+	errNoFileLoaders            = errors.New("no file loaders are registered")
+	errUnsupportedFileExtension = errors.New("unsupported file extension")
+)
+
+// FileLoader loads the file at the given path into a value container.
+type FileLoader func(path string) IValueContainer
+
+// LoadGlob loads the files matching the glob pattern in lexical order, selecting their loaders by their file extensions.
+// The values of the files loaded later take precedence, and it is an error if any of the matching files has an unsupported extension.
+// None of the files are loaded if any of them cannot be loaded, and it is an error if no file loaders are registered.
+func (c *ConfigSet) LoadGlob(pattern string, options ...loadOption) error {
+	if len(c.decoder.fileLoaders) == 0 {
+		return fmt.Errorf("%w: %w", ErrCannotOpenConfig, errNoFileLoaders)
+	}
+
+	paths, err := filepath.Glob(pattern)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCannotOpenConfig, err)
+	}
+
+	slices.Sort(paths)
+
+	var (
+		filePaths   []string
+		fileLoaders []FileLoader
+	)
+
+	// the loaders are looked up before loading any of the files, so that none of them are loaded if any of them is unsupported
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return fmt.Errorf("%w: %w", ErrCannotOpenConfig, err)
+		}
+
+		if info.IsDir() {
+			continue
+		}
+
+		fileLoader, ok := c.getFileLoader(path)
+		if !ok {
+			return fmt.Errorf("%w: %w: %s", ErrCannotOpenConfig, errUnsupportedFileExtension, path)
+		}
+
+		filePaths = append(filePaths, path)
+		fileLoaders = append(fileLoaders, fileLoader)
+	}
+
+	return c.loadFiles(filePaths, fileLoaders, options...)
+}
+
+// LoadDirectory loads the files of the directory in lexical order, such as the files of a conf.d directory,
+// selecting their loaders by their file extensions. The values of the files loaded later take precedence.
+// Subdirectories and files with unsupported extensions are skipped, and none of the files are loaded if any of them cannot be loaded.
+// It is an error if no file loaders are registered, as all of the files would be skipped.
+func (c *ConfigSet) LoadDirectory(path string, options ...loadOption) error {
+	if len(c.decoder.fileLoaders) == 0 {
+		return fmt.Errorf("%w: %w", ErrCannotOpenConfig, errNoFileLoaders)
+	}
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return fmt.Errorf("%w: %w", ErrCannotOpenConfig, err)
+	}
+
+	var (
+		filePaths   []string
+		fileLoaders []FileLoader
+	)
+
+	for _, entry := range entries {
+		filePath := filepath.Join(path, entry.Name())
+
+		fileLoader, ok := c.getFileLoader(filePath)
+		if !ok || entry.IsDir() {
+			continue
+		}
+
+		filePaths = append(filePaths, filePath)
+		fileLoaders = append(fileLoaders, fileLoader)
+	}
+
+	return c.loadFiles(filePaths, fileLoaders, options...)
+}
+
+// loadFiles reads and parses all of the files before loading any of them, and loads them into a copy of the values of the config set,
+// which replaces them only if all of the files were loaded, so that the config set is left unchanged if any of them cannot be loaded.
+func (c *ConfigSet) loadFiles(filePaths []string, fileLoaders []FileLoader, options ...loadOption) error {
+	valueContainers := make([]IValueContainer, len(filePaths))
+
+	for i, filePath := range filePaths {
+		valueContainer := fileLoaders[i](filePath)
+
+		if errs := valueContainer.Errors(); len(errs) > 0 {
+			return fmt.Errorf("%s: %w: %w", filePath, ErrCannotLoadConfig, errors.Join(errs...))
+		}
+
+		valueContainers[i] = valueContainer
+	}
+
+	var (
+		value         = copyValue(*c.value)
+		configSetCopy = &ConfigSet{
+			value:   &value,
+			decoder: c.decoder,
+			path:    c.path,
+			usage:   c.usage,
+		}
+	)
+
+	for i, valueContainer := range valueContainers {
+		if err := configSetCopy.Load(valueContainer, options...); err != nil {
+			return fmt.Errorf("%s: %w", filePaths[i], err)
+		}
+	}
+
+	*c.value = value
+
+	return nil
+}
+
+func (c *ConfigSet) getFileLoader(path string) (FileLoader, bool) {
+	fileLoader, ok := c.decoder.fileLoaders[strings.ToLower(filepath.Ext(path))]
+
+	return fileLoader, ok
+}
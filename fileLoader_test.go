@@ -0,0 +1,183 @@
+package confiq_test
+
+import (
+	"testing"
+
+	"github.com/greencoda/confiq"
+	confiqfiles "github.com/greencoda/confiq/loaders/files"
+	confiqini "github.com/greencoda/confiq/loaders/ini"
+	"github.com/stretchr/testify/suite"
+)
+
+type FileLoaderTestSuite struct {
+	suite.Suite
+}
+
+func Test_FileLoaderTestSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(FileLoaderTestSuite))
+}
+
+func loadINIFile(path string) confiq.IValueContainer {
+	return confiqini.Load().FromFile(path)
+}
+
+func newFileLoaderConfigSet() *confiq.ConfigSet {
+	return confiq.New(
+		confiq.WithFileLoaders(confiqfiles.FileLoaders()),
+	)
+}
+
+func (s *FileLoaderTestSuite) Test_LoadDirectory() {
+	configSet := newFileLoaderConfigSet()
+
+	s.Require().NoError(configSet.LoadDirectory("./testdata/conf.d"))
+
+	value, err := configSet.Get("")
+	s.Require().NoError(err)
+
+	s.Equal(map[string]any{
+		"db": map[string]any{
+			"host": "db.example.com",
+			"port": float64(5432),
+		},
+		"name": "local",
+	}, value)
+}
+
+func (s *FileLoaderTestSuite) Test_LoadDirectory_WithPrefix() {
+	configSet := newFileLoaderConfigSet()
+
+	s.Require().NoError(configSet.LoadDirectory("./testdata/conf.d", confiq.WithPrefix("config")))
+
+	value, err := configSet.Get("config.db.host")
+	s.Require().NoError(err)
+
+	s.Equal("db.example.com", value)
+}
+
+func (s *FileLoaderTestSuite) Test_LoadDirectory_InvalidPath() {
+	configSet := newFileLoaderConfigSet()
+
+	s.ErrorIs(configSet.LoadDirectory("./testdata/nonexistent"), confiq.ErrCannotOpenConfig)
+}
+
+func (s *FileLoaderTestSuite) Test_LoadDirectory_InvalidFile() {
+	configSet := newFileLoaderConfigSet()
+
+	s.Require().NoError(configSet.Set("name", "initial"))
+
+	err := configSet.LoadDirectory("./testdata/conf.invalid")
+
+	s.ErrorIs(err, confiq.ErrCannotLoadConfig)
+	s.ErrorContains(err, "20-broken.json")
+
+	// none of the files are loaded if any of them is invalid
+	value, getErr := configSet.Get("")
+	s.Require().NoError(getErr)
+
+	s.Equal(map[string]any{"name": "initial"}, value)
+}
+
+func (s *FileLoaderTestSuite) Test_LoadDirectory_ConflictingFiles() {
+	configSet := newFileLoaderConfigSet()
+
+	s.Require().NoError(configSet.Set("name", "initial"))
+
+	err := configSet.LoadDirectory("./testdata/conf.mixed", confiq.WithPrefix("config"))
+
+	s.Error(err)
+	s.ErrorContains(err, "20-list.json")
+
+	// the values of the files loaded before the conflicting one are discarded as well
+	value, getErr := configSet.Get("")
+	s.Require().NoError(getErr)
+
+	s.Equal(map[string]any{"name": "initial"}, value)
+}
+
+func (s *FileLoaderTestSuite) Test_LoadDirectory_WithoutFileLoaders() {
+	configSet := confiq.New()
+
+	s.ErrorIs(configSet.LoadDirectory("./testdata/conf.d"), confiq.ErrCannotOpenConfig)
+	s.False(configSet.Has("db"))
+}
+
+func (s *FileLoaderTestSuite) Test_LoadGlob() {
+	configSet := newFileLoaderConfigSet()
+
+	s.Require().NoError(configSet.LoadGlob("./testdata/conf.d/*0-*"))
+
+	value, err := configSet.Get("db.host")
+	s.Require().NoError(err)
+
+	s.Equal("db.example.com", value)
+}
+
+func (s *FileLoaderTestSuite) Test_LoadGlob_NoMatches() {
+	configSet := newFileLoaderConfigSet()
+
+	s.Require().NoError(configSet.LoadGlob("./testdata/conf.d/*.xml"))
+
+	s.False(configSet.Has("db"))
+}
+
+func (s *FileLoaderTestSuite) Test_LoadGlob_UnsupportedExtension() {
+	configSet := newFileLoaderConfigSet()
+
+	s.ErrorIs(configSet.LoadGlob("./testdata/glob/app.*"), confiq.ErrCannotOpenConfig)
+
+	// none of the files are loaded if any of them is unsupported
+	s.False(configSet.Has("LEVEL"))
+}
+
+func (s *FileLoaderTestSuite) Test_LoadGlob_InvalidFile() {
+	configSet := newFileLoaderConfigSet()
+
+	err := configSet.LoadGlob("./testdata/invalid.json")
+
+	s.ErrorIs(err, confiq.ErrCannotLoadConfig)
+	s.ErrorContains(err, "testdata/invalid.json")
+}
+
+func (s *FileLoaderTestSuite) Test_LoadGlob_InvalidSecondFile() {
+	configSet := newFileLoaderConfigSet()
+
+	err := configSet.LoadGlob("./testdata/conf.invalid/*.json")
+
+	s.ErrorIs(err, confiq.ErrCannotLoadConfig)
+	s.ErrorContains(err, "20-broken.json")
+
+	s.False(configSet.Has("db"))
+}
+
+func (s *FileLoaderTestSuite) Test_LoadGlob_WithoutFileLoaders() {
+	configSet := confiq.New()
+
+	s.ErrorIs(configSet.LoadGlob("./testdata/conf.d/*.json"), confiq.ErrCannotOpenConfig)
+	s.False(configSet.Has("db"))
+}
+
+func (s *FileLoaderTestSuite) Test_LoadGlob_InvalidPattern() {
+	configSet := newFileLoaderConfigSet()
+
+	s.ErrorIs(configSet.LoadGlob("./testdata/["), confiq.ErrCannotOpenConfig)
+}
+
+func (s *FileLoaderTestSuite) Test_LoadGlob_WithFileLoader() {
+	configSet := confiq.New(
+		confiq.WithFileLoaders(confiqfiles.FileLoaders()),
+		confiq.WithFileLoader("INI", loadINIFile),
+	)
+
+	s.Require().NoError(configSet.LoadGlob("./testdata/glob/app.*"))
+
+	value, err := configSet.Get("")
+	s.Require().NoError(err)
+
+	s.Equal(map[string]any{
+		"LEVEL": "debug",
+		"level": "info",
+	}, value)
+}
@@ -4,14 +4,27 @@ go 1.22.0
 
 require (
 	github.com/goccy/go-yaml v1.18.0
+	github.com/google/go-jsonnet v0.20.0
 	github.com/hashicorp/go-envparse v0.1.0
+	github.com/hashicorp/hcl/v2 v2.22.0
 	github.com/pelletier/go-toml v1.9.5
 	github.com/stretchr/testify v1.10.0
+	github.com/zclconf/go-cty v1.13.0
 )
 
 require (
+	github.com/agext/levenshtein v1.2.1 // indirect
+	github.com/apparentlymart/go-textseg/v13 v13.0.0 // indirect
+	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/mitchellh/go-wordwrap v0.0.0-20150314170334-ad45545899c7 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
 	github.com/stretchr/objx v0.5.2 // indirect
+	golang.org/x/mod v0.8.0 // indirect
+	golang.org/x/sys v0.5.0 // indirect
+	golang.org/x/text v0.11.0 // indirect
+	golang.org/x/tools v0.6.0 // indirect
+	gopkg.in/yaml.v2 v2.2.7 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
+	sigs.k8s.io/yaml v1.1.0 // indirect
 )
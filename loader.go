@@ -3,7 +3,6 @@ package confiq
 import (
 	"errors"
 	"fmt"
-	"maps"
 )
 
 const noPrefix = ""
@@ -102,7 +101,7 @@ func (c *ConfigSet) applyMapWithoutPrefix(newValue map[string]any) error {
 		return errCannotApplyMapValue
 	}
 
-	maps.Copy(valueMap, newValue)
+	mergeMaps(valueMap, newValue)
 
 	return nil
 }
@@ -129,7 +128,7 @@ func (c *ConfigSet) applyMapWithPrefix(newValue map[string]any, prefix string) e
 		return errCannotApplyMapValue
 	}
 
-	maps.Copy(valueMapAtPathMap, newValue)
+	mergeMaps(valueMapAtPathMap, newValue)
 
 	return nil
 }
@@ -181,3 +180,28 @@ func (c *ConfigSet) applySliceWithPrefix(newValue []any, prefix string) error {
 
 	return nil
 }
+
+// mergeMaps copies the values of the source map into the destination map, merging the values which are present in both of them.
+func mergeMaps(destinationMap, sourceMap map[string]any) {
+	for key, sourceValue := range sourceMap {
+		destinationMap[key] = mergeValues(destinationMap[key], sourceValue)
+	}
+}
+
+// mergeValues merges the source value into the destination value, so that later loaded values can override nested keys.
+// Maps are merged by their keys, while other values, including slices, are replaced.
+func mergeValues(destinationValue, sourceValue any) any {
+	sourceValueMap, ok := sourceValue.(map[string]any)
+	if !ok {
+		return sourceValue
+	}
+
+	destinationValueMap, ok := destinationValue.(map[string]any)
+	if !ok {
+		return sourceValue
+	}
+
+	mergeMaps(destinationValueMap, sourceValueMap)
+
+	return destinationValueMap
+}
@@ -120,6 +120,50 @@ func (s *LoaderTestSuite) Test_LoadMaps_WithSamePrefix() {
 	s.NoError(getErr)
 }
 
+func (s *LoaderTestSuite) Test_LoadMaps_MergesNestedMaps() {
+	s.valueContainer1.On("Errors").Return([]error{})
+	s.valueContainer1.On("Get").Once().Return([]any{
+		map[string]any{"db": map[string]any{"host": "localhost", "port": 5432}, "list": []any{1, 2}},
+	})
+	s.valueContainer2.On("Errors").Return([]error{})
+	s.valueContainer2.On("Get").Once().Return([]any{
+		map[string]any{"db": map[string]any{"host": "example.com"}, "list": []any{3}},
+	})
+
+	loadErr1 := s.configSet.Load(s.valueContainer1, confiq.WithPrefix("prefix"))
+	s.Require().NoError(loadErr1)
+
+	loadErr2 := s.configSet.Load(s.valueContainer2, confiq.WithPrefix("prefix"))
+	s.Require().NoError(loadErr2)
+
+	value, getErr := s.configSet.Get("prefix")
+
+	s.Equal(map[string]any{"db": map[string]any{"host": "example.com", "port": 5432}, "list": []any{3}}, value)
+	s.NoError(getErr)
+}
+
+func (s *LoaderTestSuite) Test_LoadMaps_ReplacesNestedSlices() {
+	s.valueContainer1.On("Errors").Return([]error{})
+	s.valueContainer1.On("Get").Once().Return([]any{
+		map[string]any{"network": map[string]any{"hosts": []any{"a", "b", "c"}}},
+	})
+	s.valueContainer2.On("Errors").Return([]error{})
+	s.valueContainer2.On("Get").Once().Return([]any{
+		map[string]any{"network": map[string]any{"hosts": []any{"x"}}},
+	})
+
+	loadErr1 := s.configSet.Load(s.valueContainer1)
+	s.Require().NoError(loadErr1)
+
+	loadErr2 := s.configSet.Load(s.valueContainer2)
+	s.Require().NoError(loadErr2)
+
+	value, getErr := s.configSet.Get("network.hosts")
+
+	s.Equal([]any{"x"}, value)
+	s.NoError(getErr)
+}
+
 func (s *LoaderTestSuite) Test_LoadMaps_WithDifferentPrefix() {
 	s.valueContainer1.On("Errors").Return([]error{})
 	s.valueContainer1.On("Get").Once().Return([]any{
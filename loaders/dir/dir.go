@@ -0,0 +1,206 @@
+// Package confiqdir allows confiq values to be loaded from directories in which each file holds a single value,
+// such as the ConfigMaps and Secrets mounted by Kubernetes, or the secrets of Docker in /run/secrets.
+package confiqdir
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+var (
+	ErrCannotOpenDirectory = errors.New("cannot open directory")
+	ErrCannotReadDirectory = errors.New("cannot read directory")
+)
+
+var (
+	errConflictingKeys = errors.New("conflicting keys")
+	errEmptyKeySegment = errors.New("empty key segment")
+	errSymlinkLoop     = errors.New("symlink loop")
+)
+
+// hiddenEntryPrefix is the prefix of the entries which are skipped, such as the ..data symlink of Kubernetes,
+// and the timestamped directories it points to, which hold the same files as the directory itself.
+const hiddenEntryPrefix = ".."
+
+// Container is a struct that holds the loaded values.
+type Container struct {
+	values       []any
+	errors       []error
+	keySeparator string
+}
+
+// Get returns the loaded directory values.
+func (c *Container) Get() []any {
+	return c.values
+}
+
+// Errors returns the errors that occurred during the loading process.
+func (c *Container) Errors() []error {
+	return c.errors
+}
+
+// Load creates an empty container, into which the directory values can be loaded.
+func Load() *Container {
+	container := &Container{
+		values:       nil,
+		errors:       nil,
+		keySeparator: "",
+	}
+
+	return container
+}
+
+// WithKeySeparator sets the separator at which the filenames are split into nested keys, e.g. with "__" the content of the file db__host is loaded under db.host.
+// By default the filenames are not split.
+func (c *Container) WithKeySeparator(separator string) *Container {
+	c.keySeparator = separator
+
+	return c
+}
+
+// FromDirectory loads the files of the directory at the given path, using their names as keys and their contents trimmed of whitespace as values.
+// Nested directories are loaded as nested maps, symlinks are followed, and the entries whose names start with ".." are skipped.
+func (c *Container) FromDirectory(path string) *Container {
+	info, err := os.Stat(filepath.Clean(path))
+	if err != nil {
+		c.errors = append(c.errors, fmt.Errorf("%w: %w", ErrCannotOpenDirectory, err))
+
+		return c
+	}
+
+	if !info.IsDir() {
+		c.errors = append(c.errors, fmt.Errorf("%w: %s is not a directory", ErrCannotOpenDirectory, path))
+
+		return c
+	}
+
+	value, err := c.readDirectory(filepath.Clean(path), nil)
+	if err != nil {
+		c.errors = append(c.errors, fmt.Errorf("%w: %w", ErrCannotReadDirectory, err))
+
+		return c
+	}
+
+	c.values = append(c.values, value)
+
+	return c
+}
+
+// readDirectory reads the directory into a map, keeping track of the real paths of its parent directories to detect symlink loops.
+func (c *Container) readDirectory(path string, parentPaths []string) (map[string]any, error) {
+	realPath, err := filepath.EvalSymlinks(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if slices.Contains(parentPaths, realPath) {
+		return nil, fmt.Errorf("%w: %s", errSymlinkLoop, path)
+	}
+
+	parentPaths = append(parentPaths, realPath)
+
+	entries, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make(map[string]any)
+
+	for _, entry := range entries {
+		if strings.HasPrefix(entry.Name(), hiddenEntryPrefix) {
+			continue
+		}
+
+		value, err := c.readEntry(filepath.Join(path, entry.Name()), parentPaths)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := c.setValue(result, entry.Name(), value); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// readEntry reads the file or directory at the given path, following symlinks.
+func (c *Container) readEntry(path string, parentPaths []string) (any, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if info.IsDir() {
+		return c.readDirectory(path, parentPaths)
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return strings.TrimSpace(string(content)), nil
+}
+
+// setValue sets the value under the name of the entry, which is split into nested keys at the key separator.
+// The values of nested directories are merged with the values of the files whose names share their prefix.
+func (c *Container) setValue(result map[string]any, name string, value any) error {
+	keys := []string{name}
+	if c.keySeparator != "" {
+		keys = strings.Split(name, c.keySeparator)
+	}
+
+	parent := result
+
+	for i, key := range keys {
+		if key == "" {
+			return fmt.Errorf("%w: %s", errEmptyKeySegment, name)
+		}
+
+		if i == len(keys)-1 {
+			return mergeValue(parent, key, value, name)
+		}
+
+		if parent[key] == nil {
+			parent[key] = make(map[string]any)
+		}
+
+		child, ok := parent[key].(map[string]any)
+		if !ok {
+			return fmt.Errorf("%w: %s", errConflictingKeys, name)
+		}
+
+		parent = child
+	}
+
+	return nil
+}
+
+func mergeValue(parent map[string]any, key string, value any, name string) error {
+	existingValue, exists := parent[key]
+	if !exists {
+		parent[key] = value
+
+		return nil
+	}
+
+	existingMap, existingIsMap := existingValue.(map[string]any)
+	valueMap, valueIsMap := value.(map[string]any)
+
+	if !existingIsMap || !valueIsMap {
+		return fmt.Errorf("%w: %s", errConflictingKeys, name)
+	}
+
+	for childKey, childValue := range valueMap {
+		if err := mergeValue(existingMap, childKey, childValue, name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package confiqdir_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	confiqdir "github.com/greencoda/confiq/loaders/dir"
+	"github.com/stretchr/testify/suite"
+)
+
+type DirTestSuite struct {
+	suite.Suite
+
+	c   *confiqdir.Container
+	dir string
+}
+
+func Test_DirTestSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(DirTestSuite))
+}
+
+func (s *DirTestSuite) SetupTest() {
+	s.c = confiqdir.Load()
+	s.Require().NotNil(s.c)
+
+	s.dir = s.T().TempDir()
+}
+
+func (s *DirTestSuite) writeFile(path, content string) {
+	fullPath := filepath.Join(s.dir, path)
+
+	s.Require().NoError(os.MkdirAll(filepath.Dir(fullPath), 0o755))
+	s.Require().NoError(os.WriteFile(fullPath, []byte(content), 0o600))
+}
+
+func (s *DirTestSuite) symlink(target, path string) {
+	s.Require().NoError(os.Symlink(target, filepath.Join(s.dir, path)))
+}
+
+func (s *DirTestSuite) Test_FromDirectory() {
+	s.writeFile("username", "admin\n")
+	s.writeFile("password", "  secret  \n")
+	s.writeFile("db/host", "localhost")
+	s.writeFile("db/replica/host", "replica")
+
+	s.c.FromDirectory(s.dir)
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	s.Equal(map[string]any{
+		"username": "admin",
+		"password": "secret",
+		"db": map[string]any{
+			"host":    "localhost",
+			"replica": map[string]any{"host": "replica"},
+		},
+	}, s.c.Get()[0])
+}
+
+func (s *DirTestSuite) Test_FromDirectory_Empty() {
+	s.c.FromDirectory(s.dir)
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	s.Equal(map[string]any{}, s.c.Get()[0])
+}
+
+func (s *DirTestSuite) Test_FromDirectory_KubernetesLayout() {
+	s.writeFile("..2024_01_01_00_00_00.000000000/host", "localhost\n")
+	s.writeFile("..2024_01_01_00_00_00.000000000/port", "5432\n")
+	s.symlink("..2024_01_01_00_00_00.000000000", "..data")
+	s.symlink("..data/host", "host")
+	s.symlink("..data/port", "port")
+
+	s.c.FromDirectory(s.dir)
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	s.Equal(map[string]any{
+		"host": "localhost",
+		"port": "5432",
+	}, s.c.Get()[0])
+}
+
+func (s *DirTestSuite) Test_FromDirectory_WithKeySeparator() {
+	s.writeFile("db__host", "localhost")
+	s.writeFile("db__port", "5432")
+	s.writeFile("db/name", "app")
+	s.writeFile("name", "service")
+
+	s.c.WithKeySeparator("__").FromDirectory(s.dir)
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	s.Equal(map[string]any{
+		"name": "service",
+		"db": map[string]any{
+			"host": "localhost",
+			"port": "5432",
+			"name": "app",
+		},
+	}, s.c.Get()[0])
+}
+
+func (s *DirTestSuite) Test_FromDirectory_WithKeySeparator_ConflictingKeys() {
+	s.writeFile("db", "localhost")
+	s.writeFile("db__host", "localhost")
+
+	s.c.WithKeySeparator("__").FromDirectory(s.dir)
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqdir.ErrCannotReadDirectory)
+}
+
+func (s *DirTestSuite) Test_FromDirectory_WithKeySeparator_EmptyKeySegment() {
+	s.writeFile("db____host", "localhost")
+
+	s.c.WithKeySeparator("__").FromDirectory(s.dir)
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqdir.ErrCannotReadDirectory)
+}
+
+func (s *DirTestSuite) Test_FromDirectory_BrokenSymlink() {
+	s.symlink("nonexistent", "host")
+
+	s.c.FromDirectory(s.dir)
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqdir.ErrCannotReadDirectory)
+}
+
+func (s *DirTestSuite) Test_FromDirectory_SymlinkLoop() {
+	s.writeFile("nested/host", "localhost")
+	s.symlink("..", filepath.Join("nested", "parent"))
+
+	s.c.FromDirectory(s.dir)
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqdir.ErrCannotReadDirectory)
+}
+
+func (s *DirTestSuite) Test_FromDirectory_InvalidPath() {
+	s.c.FromDirectory(filepath.Join(s.dir, "nonexistent"))
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqdir.ErrCannotOpenDirectory)
+}
+
+func (s *DirTestSuite) Test_FromDirectory_NotADirectory() {
+	s.writeFile("host", "localhost")
+
+	s.c.FromDirectory(filepath.Join(s.dir, "host"))
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqdir.ErrCannotOpenDirectory)
+}
@@ -0,0 +1,43 @@
+// Package confiqfiles provides the loaders of the common config file formats,
+// which can be registered on a confiq.ConfigSet for its LoadGlob and LoadDirectory methods.
+package confiqfiles
+
+import (
+	"github.com/greencoda/confiq"
+	confiqenv "github.com/greencoda/confiq/loaders/env"
+	confiqjson "github.com/greencoda/confiq/loaders/json"
+	confiqtoml "github.com/greencoda/confiq/loaders/toml"
+	confiqyaml "github.com/greencoda/confiq/loaders/yaml"
+)
+
+// FileLoaders returns the loaders of the .env, .json, .toml, .yaml and .yml files, keyed by their extensions,
+// which can be registered with the confiq.WithFileLoaders option.
+func FileLoaders() map[string]confiq.FileLoader {
+	return map[string]confiq.FileLoader{
+		".env":  LoadEnvFile,
+		".json": LoadJSONFile,
+		".toml": LoadTOMLFile,
+		".yaml": LoadYAMLFile,
+		".yml":  LoadYAMLFile,
+	}
+}
+
+// LoadEnvFile loads the .env file at the given path.
+func LoadEnvFile(path string) confiq.IValueContainer {
+	return confiqenv.Load().FromFile(path)
+}
+
+// LoadJSONFile loads the JSON file at the given path.
+func LoadJSONFile(path string) confiq.IValueContainer {
+	return confiqjson.Load().FromFile(path)
+}
+
+// LoadTOMLFile loads the TOML file at the given path.
+func LoadTOMLFile(path string) confiq.IValueContainer {
+	return confiqtoml.Load().FromFile(path)
+}
+
+// LoadYAMLFile loads the YAML file at the given path.
+func LoadYAMLFile(path string) confiq.IValueContainer {
+	return confiqyaml.Load().FromFile(path)
+}
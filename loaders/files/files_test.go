@@ -0,0 +1,63 @@
+package confiqfiles_test
+
+import (
+	"testing"
+
+	"github.com/greencoda/confiq"
+	confiqfiles "github.com/greencoda/confiq/loaders/files"
+	"github.com/stretchr/testify/suite"
+)
+
+type FilesTestSuite struct {
+	suite.Suite
+}
+
+func Test_FilesTestSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(FilesTestSuite))
+}
+
+func (s *FilesTestSuite) Test_FileLoaders() {
+	s.ElementsMatch([]string{".env", ".json", ".toml", ".yaml", ".yml"}, keys(confiqfiles.FileLoaders()))
+}
+
+func (s *FilesTestSuite) Test_FileLoaders_LoadDirectory() {
+	configSet := confiq.New(
+		confiq.WithFileLoaders(confiqfiles.FileLoaders()),
+	)
+
+	s.Require().NoError(configSet.LoadDirectory("testdata"))
+
+	value, err := configSet.Get("")
+	s.Require().NoError(err)
+
+	s.Equal(map[string]any{
+		"TEST_BOOL":   "true",
+		"test_string": "test",
+	}, value)
+}
+
+func (s *FilesTestSuite) Test_LoadFiles() {
+	for path, fileLoader := range map[string]confiq.FileLoader{
+		"testdata/valid.env":  confiqfiles.LoadEnvFile,
+		"testdata/valid.json": confiqfiles.LoadJSONFile,
+		"testdata/valid.toml": confiqfiles.LoadTOMLFile,
+		"testdata/valid.yaml": confiqfiles.LoadYAMLFile,
+	} {
+		valueContainer := fileLoader(path)
+
+		s.Empty(valueContainer.Errors(), path)
+		s.Len(valueContainer.Get(), 1, path)
+	}
+}
+
+func keys(fileLoaders map[string]confiq.FileLoader) []string {
+	extensions := make([]string, 0, len(fileLoaders))
+
+	for extension := range fileLoaders {
+		extensions = append(extensions, extension)
+	}
+
+	return extensions
+}
@@ -0,0 +1,236 @@
+// Package confiqflags allows confiq values to be loaded from command-line flags,
+// either by parsing the arguments directly, or from the flags of a flag.FlagSet.
+package confiqflags
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/greencoda/confiq"
+)
+
+var (
+	ErrCannotParseArgs   = errors.New("cannot parse args")
+	ErrCannotReadFlagSet = errors.New("cannot read flag set")
+)
+
+var errInvalidFlag = errors.New("invalid flag")
+
+const (
+	flagPrefix          = "-"
+	flagValueSeparator  = "="
+	argsTerminator      = "--"
+	implicitFlagValue   = "true"
+	registeredFlagUsage = "sets the config value at %s"
+)
+
+// Container is a struct that holds the loaded values.
+type Container struct {
+	values     []any
+	flagValues []flagValue
+	errors     []error
+}
+
+// flagValue is a loaded flag value, with the name of the flag as its path.
+type flagValue struct {
+	path  string
+	value string
+}
+
+// Get returns the loaded flag values.
+func (c *Container) Get() []any {
+	return c.values
+}
+
+// Errors returns the errors that occurred during the loading process.
+func (c *Container) Errors() []error {
+	return c.errors
+}
+
+// Load creates an empty container, into which the flag values can be loaded.
+func Load() *Container {
+	container := new(Container)
+
+	return container
+}
+
+// FromCommandLine loads the flags from the command-line arguments of the program, which are the arguments of os.Args following the program name.
+func (c *Container) FromCommandLine() *Container {
+	return c.FromArgs(os.Args[1:])
+}
+
+// FromArgs loads the flags from the given arguments, using the names of the flags as paths, e.g. --db.host=localhost or --servers[0].port 80.
+// Flags may have one or two leading dashes, and their values may follow them after an equals sign or as the next argument.
+// Flags which are followed by another flag or by nothing at all are set to "true". Values starting with a dash are only taken
+// from the next argument if they are numbers, e.g. --offset -5, otherwise they must follow an equals sign, e.g. --pattern=-v.
+// Parsing stops before the first non-flag argument, or after the "--" terminator.
+func (c *Container) FromArgs(args []string) *Container {
+	var (
+		values     = confiq.New()
+		flagValues []flagValue
+	)
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+
+		if arg == argsTerminator || !strings.HasPrefix(arg, flagPrefix) || arg == flagPrefix {
+			break
+		}
+
+		name, value, hasValue := strings.Cut(strings.TrimPrefix(strings.TrimPrefix(arg, flagPrefix), flagPrefix), flagValueSeparator)
+
+		if name == "" || strings.HasPrefix(name, flagPrefix) {
+			c.errors = append(c.errors, fmt.Errorf("%w: %w: %s", ErrCannotParseArgs, errInvalidFlag, arg))
+
+			return c
+		}
+
+		if !hasValue {
+			value = implicitFlagValue
+
+			if i+1 < len(args) && isFlagValue(args[i+1]) {
+				value = args[i+1]
+				i++
+			}
+		}
+
+		if err := values.Set(name, value); err != nil {
+			c.errors = append(c.errors, fmt.Errorf("%w: %s: %w", ErrCannotParseArgs, arg, err))
+
+			return c
+		}
+
+		flagValues = append(flagValues, flagValue{path: name, value: value})
+	}
+
+	c.appendValues(values, flagValues)
+
+	return c
+}
+
+// isFlagValue reports whether the argument following a flag is its value rather than another flag.
+func isFlagValue(arg string) bool {
+	if !strings.HasPrefix(arg, flagPrefix) {
+		return true
+	}
+
+	_, err := strconv.ParseFloat(arg, 64)
+
+	return err == nil
+}
+
+// FromFlagSet loads the flags of the parsed flag set, using the names of the flags as paths.
+// Only the flags which were set during parsing are loaded, so that their defaults don't override the values loaded from other sources.
+func (c *Container) FromFlagSet(flagSet *flag.FlagSet) *Container {
+	if flagSet == nil {
+		c.errors = append(c.errors, ErrCannotReadFlagSet)
+
+		return c
+	}
+
+	var (
+		values     = confiq.New()
+		flagValues []flagValue
+		err        error
+	)
+
+	flagSet.Visit(func(f *flag.Flag) {
+		if err == nil {
+			err = values.Set(f.Name, f.Value.String())
+			flagValues = append(flagValues, flagValue{path: f.Name, value: f.Value.String()})
+		}
+	})
+
+	if err != nil {
+		c.errors = append(c.errors, fmt.Errorf("%w: %w", ErrCannotReadFlagSet, err))
+
+		return c
+	}
+
+	c.appendValues(values, flagValues)
+
+	return c
+}
+
+func (c *Container) appendValues(values *confiq.ConfigSet, flagValues []flagValue) {
+	value, err := values.Get("")
+	if err != nil || value == nil {
+		value = map[string]any{}
+	}
+
+	c.values = append(c.values, value)
+	c.flagValues = append(c.flagValues, flagValues...)
+}
+
+// ApplyTo sets the loaded flag values on the config set at their paths, so that indexed flags such as --servers[0].port
+// only override the elements they address, keeping the other elements loaded from other sources.
+// Loading the container with the Load method of the config set replaces the slices instead, like the ones of any other source.
+func (c *Container) ApplyTo(configSet *confiq.ConfigSet) error {
+	if len(c.errors) > 0 {
+		return fmt.Errorf("%w: %w", confiq.ErrCannotLoadConfig, errors.Join(c.errors...))
+	}
+
+	for _, flagValue := range c.flagValues {
+		if err := configSet.Set(flagValue.path, flagValue.value); err != nil {
+			return fmt.Errorf("%w: %s: %w", confiq.ErrCannotLoadConfig, flagValue.path, err)
+		}
+	}
+
+	return nil
+}
+
+// RegisterFlags defines a flag on the flag set for each of the fields, named after the path of the field, with the default value of the field.
+// Fields which already have a flag with the same name defined on the flag set are skipped.
+// The flags of bool fields can be set without a value, e.g. --debug.
+func RegisterFlags(flagSet *flag.FlagSet, fields []confiq.Field) {
+	for _, field := range fields {
+		if flagSet.Lookup(field.Path) != nil {
+			continue
+		}
+
+		value := &stringValue{
+			value:  "",
+			isBool: isBoolType(field.Type),
+		}
+
+		if field.DefaultValue != nil {
+			value.value = *field.DefaultValue
+		}
+
+		flagSet.Var(value, field.Path, fmt.Sprintf(registeredFlagUsage, field.Path))
+	}
+}
+
+func isBoolType(fieldType reflect.Type) bool {
+	if fieldType.Kind() == reflect.Ptr {
+		fieldType = fieldType.Elem()
+	}
+
+	return fieldType.Kind() == reflect.Bool
+}
+
+// stringValue is a flag.Value which holds the value of the flag as a string, which is decoded by the config set.
+type stringValue struct {
+	value  string
+	isBool bool
+}
+
+func (sV *stringValue) String() string {
+	return sV.value
+}
+
+func (sV *stringValue) Set(value string) error {
+	sV.value = value
+
+	return nil
+}
+
+// IsBoolFlag allows the flags of bool fields to be set without a value.
+func (sV *stringValue) IsBoolFlag() bool {
+	return sV.isBool
+}
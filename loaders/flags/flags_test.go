@@ -0,0 +1,281 @@
+package confiqflags_test
+
+import (
+	"flag"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/greencoda/confiq"
+	confiqflags "github.com/greencoda/confiq/loaders/flags"
+	confiqjson "github.com/greencoda/confiq/loaders/json"
+	"github.com/stretchr/testify/suite"
+)
+
+type FlagsTestSuite struct {
+	suite.Suite
+
+	c *confiqflags.Container
+}
+
+func Test_FlagsTestSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(FlagsTestSuite))
+}
+
+func (s *FlagsTestSuite) SetupTest() {
+	s.c = confiqflags.Load()
+	s.Require().NotNil(s.c)
+}
+
+func (s *FlagsTestSuite) newFlagSet() *flag.FlagSet {
+	flagSet := flag.NewFlagSet("test", flag.ContinueOnError)
+	flagSet.SetOutput(io.Discard)
+
+	return flagSet
+}
+
+func (s *FlagsTestSuite) Test_FromArgs() {
+	s.c.FromArgs([]string{
+		"--db.host=localhost",
+		"-db.port", "5432",
+		"--servers[1].port=80",
+		"--servers[0].host=example.com",
+		"--debug",
+		"--verbose",
+		"--",
+		"--ignored=true",
+	})
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	s.Equal(map[string]any{
+		"db": map[string]any{
+			"host": "localhost",
+			"port": "5432",
+		},
+		"servers": []any{
+			map[string]any{"host": "example.com"},
+			map[string]any{"port": "80"},
+		},
+		"debug":   "true",
+		"verbose": "true",
+	}, s.c.Get()[0])
+}
+
+func (s *FlagsTestSuite) Test_FromArgs_NegativeValues() {
+	s.c.FromArgs([]string{
+		"--offset", "-5",
+		"--ratio", "-0.25",
+		"--pattern=-v",
+		"--debug", "-verbose",
+	})
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	s.Equal(map[string]any{
+		"offset":  "-5",
+		"ratio":   "-0.25",
+		"pattern": "-v",
+		"debug":   "true",
+		"verbose": "true",
+	}, s.c.Get()[0])
+}
+
+func (s *FlagsTestSuite) Test_FromArgs_StopsAtNonFlagArgument() {
+	s.c.FromArgs([]string{"--host=localhost", "command", "--port=80"})
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	s.Equal(map[string]any{"host": "localhost"}, s.c.Get()[0])
+}
+
+func (s *FlagsTestSuite) Test_FromArgs_Empty() {
+	s.c.FromArgs(nil)
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	s.Equal(map[string]any{}, s.c.Get()[0])
+}
+
+func (s *FlagsTestSuite) Test_FromArgs_InvalidFlag() {
+	for _, args := range [][]string{
+		{"--=value"},
+		{"---host=localhost"},
+		{"--db=localhost", "--db.host=localhost"},
+		{"--servers[0]=example.com", "--servers.host=example.com"},
+		{"--servers[999999999999999999].port=80"},
+	} {
+		container := confiqflags.Load().FromArgs(args)
+
+		s.Empty(container.Get(), args)
+		s.Require().Len(container.Errors(), 1, args)
+		s.ErrorIs(container.Errors()[0], confiqflags.ErrCannotParseArgs, args)
+	}
+}
+
+func (s *FlagsTestSuite) Test_FromFlagSet() {
+	flagSet := s.newFlagSet()
+	flagSet.String("db.host", "localhost", "")
+	flagSet.Int("db.port", 5432, "")
+	flagSet.Bool("debug", false, "")
+
+	s.Require().NoError(flagSet.Parse([]string{"--db.port=6543", "--debug"}))
+
+	s.c.FromFlagSet(flagSet)
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	s.Equal(map[string]any{
+		"db":    map[string]any{"port": "6543"},
+		"debug": "true",
+	}, s.c.Get()[0])
+}
+
+func (s *FlagsTestSuite) Test_FromFlagSet_Nil() {
+	s.c.FromFlagSet(nil)
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqflags.ErrCannotReadFlagSet)
+}
+
+func (s *FlagsTestSuite) Test_FromFlagSet_ConflictingFlags() {
+	flagSet := s.newFlagSet()
+	flagSet.String("db", "", "")
+	flagSet.String("db.host", "", "")
+
+	s.Require().NoError(flagSet.Parse([]string{"--db=localhost", "--db.host=localhost"}))
+
+	s.c.FromFlagSet(flagSet)
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqflags.ErrCannotReadFlagSet)
+}
+
+func (s *FlagsTestSuite) Test_RegisterFlags() {
+	type Config struct {
+		DB struct {
+			Host string `cfg:"host,default=localhost"`
+			Port int    `cfg:"port,default=5432"`
+		} `cfg:"db"`
+		Debug   bool          `cfg:"debug"`
+		Timeout time.Duration `cfg:"timeout,default=5s"`
+		Name    string        `cfg:"name"`
+	}
+
+	var (
+		config    Config
+		configSet = confiq.New()
+		flagSet   = s.newFlagSet()
+	)
+
+	flagSet.String("name", "custom", "")
+
+	fields, err := configSet.Fields(&config)
+	s.Require().NoError(err)
+
+	confiqflags.RegisterFlags(flagSet, fields)
+
+	s.Equal("localhost", flagSet.Lookup("db.host").DefValue)
+	s.Equal("5432", flagSet.Lookup("db.port").DefValue)
+	s.Equal("5s", flagSet.Lookup("timeout").DefValue)
+	s.Equal("custom", flagSet.Lookup("name").DefValue)
+
+	s.Require().NoError(flagSet.Parse([]string{"--db.host=example.com", "--debug", "--timeout", "10s"}))
+
+	s.Require().NoError(configSet.Load(s.c.FromFlagSet(flagSet)))
+	s.Require().NoError(configSet.Decode(&config))
+
+	s.Equal("example.com", config.DB.Host)
+	s.Equal(5432, config.DB.Port)
+	s.True(config.Debug)
+	s.Equal(10*time.Second, config.Timeout)
+}
+
+func (s *FlagsTestSuite) Test_Load_OverFile() {
+	configSet := confiq.New()
+
+	s.Require().NoError(configSet.Load(confiqjson.Load().FromFile("testdata/config.json")))
+	s.Require().NoError(configSet.Load(s.c.FromArgs([]string{"--db.host=example.com", "--servers[0].port=80"})))
+
+	value, err := configSet.Get("")
+	s.Require().NoError(err)
+
+	s.Equal(map[string]any{
+		"db": map[string]any{
+			"host": "example.com",
+			"port": float64(5432),
+		},
+		"servers": []any{
+			map[string]any{"port": "80"},
+		},
+	}, value)
+}
+
+func (s *FlagsTestSuite) Test_ApplyTo_OverFile() {
+	configSet := confiq.New()
+
+	s.Require().NoError(configSet.Load(confiqjson.Load().FromFile("testdata/config.json")))
+	s.Require().NoError(s.c.FromArgs([]string{"--db.host=example.com", "--servers[0].port=80"}).ApplyTo(configSet))
+
+	value, err := configSet.Get("")
+	s.Require().NoError(err)
+
+	s.Equal(map[string]any{
+		"db": map[string]any{
+			"host": "example.com",
+			"port": float64(5432),
+		},
+		"servers": []any{
+			map[string]any{"host": "h0", "port": "80"},
+			map[string]any{"host": "h1", "port": float64(2)},
+		},
+	}, value)
+}
+
+func (s *FlagsTestSuite) Test_ApplyTo_FromFlagSet() {
+	configSet := confiq.New()
+
+	s.Require().NoError(configSet.Load(confiqjson.Load().FromFile("testdata/config.json")))
+
+	flagSet := s.newFlagSet()
+	flagSet.String("servers[1].host", "", "")
+	s.Require().NoError(flagSet.Parse([]string{"-servers[1].host=example.com"}))
+
+	s.Require().NoError(s.c.FromFlagSet(flagSet).ApplyTo(configSet))
+
+	value, err := configSet.Get("servers")
+	s.Require().NoError(err)
+
+	s.Equal([]any{
+		map[string]any{"host": "h0", "port": float64(1)},
+		map[string]any{"host": "example.com", "port": float64(2)},
+	}, value)
+}
+
+func (s *FlagsTestSuite) Test_ApplyTo_WithErrors() {
+	configSet := confiq.New()
+
+	s.ErrorIs(s.c.FromArgs([]string{"--=value"}).ApplyTo(configSet), confiq.ErrCannotLoadConfig)
+
+	value, err := configSet.Get("")
+	s.Require().NoError(err)
+
+	s.Nil(value)
+}
+
+func (s *FlagsTestSuite) Test_ApplyTo_ConflictingValue() {
+	configSet := confiq.New()
+
+	s.Require().NoError(configSet.Set("db", "localhost"))
+
+	s.ErrorIs(s.c.FromArgs([]string{"--db.host=example.com"}).ApplyTo(configSet), confiq.ErrCannotLoadConfig)
+}
@@ -0,0 +1,256 @@
+// Package confiqhcl allows confiq values to be loaded from HCL format.
+package confiqhcl
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+var (
+	ErrCannotOpenHCLFile  = errors.New("cannot open HCL file")
+	ErrCannotReadHCLData  = errors.New("cannot read HCL data")
+	ErrCannotReadHCLBytes = errors.New("cannot read HCL bytes")
+)
+
+var (
+	errConflictingKeys       = errors.New("conflicting keys")
+	errCannotEvaluateValue   = errors.New("cannot evaluate value")
+	errUnsupportedValueType  = errors.New("unsupported value type")
+	errUnknownValue          = errors.New("value is unknown")
+	errUnsupportedBodyFormat = errors.New("unsupported body format")
+)
+
+// Container is a struct that holds the loaded values.
+type Container struct {
+	values []any
+	errors []error
+}
+
+// Get returns the loaded HCL values.
+func (c *Container) Get() []any {
+	return c.values
+}
+
+// Errors returns the errors that occurred during the loading process.
+func (c *Container) Errors() []error {
+	return c.errors
+}
+
+// Load creates an empty container, into which the HCL values can be loaded.
+func Load() *Container {
+	container := new(Container)
+
+	return container
+}
+
+// FromFile loads a HCL file from the given path.
+func (c *Container) FromFile(path string) *Container {
+	bytes, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		c.errors = append(c.errors, fmt.Errorf("%w: %w", ErrCannotOpenHCLFile, err))
+
+		return c
+	}
+
+	c.readFromBytes(bytes, path)
+
+	return c
+}
+
+// FromString loads a HCL file from the given string.
+func (c *Container) FromString(input string) *Container {
+	c.readFromBytes([]byte(input), "")
+
+	return c
+}
+
+// FromReader loads a HCL file from a reader stream.
+func (c *Container) FromReader(reader io.Reader) *Container {
+	if reader == nil {
+		c.errors = append(c.errors, ErrCannotReadHCLData)
+
+		return c
+	}
+
+	buffer := new(bytes.Buffer)
+
+	if _, err := buffer.ReadFrom(reader); err != nil {
+		c.errors = append(c.errors, fmt.Errorf("%w: %w", ErrCannotReadHCLData, err))
+
+		return c
+	}
+
+	c.readFromBytes(buffer.Bytes(), "")
+
+	return c
+}
+
+// FromBytes loads a HCL file from the given bytes.
+func (c *Container) FromBytes(input []byte) *Container {
+	c.readFromBytes(input, "")
+
+	return c
+}
+
+func (c *Container) readFromBytes(input []byte, filename string) {
+	file, diags := hclsyntax.ParseConfig(input, filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		c.errors = append(c.errors, fmt.Errorf("%w: %w", ErrCannotReadHCLBytes, diags))
+
+		return
+	}
+
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		c.errors = append(c.errors, fmt.Errorf("%w: %w", ErrCannotReadHCLBytes, errUnsupportedBodyFormat))
+
+		return
+	}
+
+	value, err := convertBody(body)
+	if err != nil {
+		c.errors = append(c.errors, fmt.Errorf("%w: %w", ErrCannotReadHCLBytes, err))
+
+		return
+	}
+
+	c.values = append(c.values, value)
+}
+
+// convertBody converts the attributes and blocks of the body into a map.
+// Blocks are nested under their type and labels, e.g. `server "web" {}` under server.web,
+// and repeated blocks with the same type and labels are collected into a slice.
+func convertBody(body *hclsyntax.Body) (map[string]any, error) {
+	result := make(map[string]any, len(body.Attributes)+len(body.Blocks))
+
+	for name, attribute := range body.Attributes {
+		// expressions are evaluated without variables or functions, as the config has no context to refer to
+		ctyValue, diags := attribute.Expr.Value(nil)
+		if diags.HasErrors() {
+			return nil, fmt.Errorf("%w %s: %w", errCannotEvaluateValue, name, diags)
+		}
+
+		value, err := convertValue(ctyValue)
+		if err != nil {
+			return nil, fmt.Errorf("%w at %s", err, name)
+		}
+
+		result[name] = value
+	}
+
+	for _, block := range body.Blocks {
+		if _, ok := body.Attributes[block.Type]; ok {
+			return nil, fmt.Errorf("%w: %s", errConflictingKeys, block.Type)
+		}
+
+		blockValue, err := convertBody(block.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			parent = result
+			path   = append([]string{block.Type}, block.Labels...)
+			key    = path[len(path)-1]
+		)
+
+		for _, pathKey := range path[:len(path)-1] {
+			if parent[pathKey] == nil {
+				parent[pathKey] = make(map[string]any)
+			}
+
+			child, ok := parent[pathKey].(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("%w: %s", errConflictingKeys, pathKey)
+			}
+
+			parent = child
+		}
+
+		switch existingValue := parent[key].(type) {
+		case nil:
+			parent[key] = blockValue
+		case []any:
+			parent[key] = append(existingValue, blockValue)
+		default:
+			parent[key] = []any{existingValue, blockValue}
+		}
+	}
+
+	return result, nil
+}
+
+func convertValue(value cty.Value) (any, error) {
+	if value.IsNull() {
+		return nil, nil
+	}
+
+	if !value.IsKnown() {
+		return nil, errUnknownValue
+	}
+
+	valueType := value.Type()
+
+	switch {
+	case valueType == cty.String:
+		return value.AsString(), nil
+	case valueType == cty.Bool:
+		return value.True(), nil
+	case valueType == cty.Number:
+		return convertNumber(value.AsBigFloat()), nil
+	case valueType.IsListType(), valueType.IsSetType(), valueType.IsTupleType():
+		result := make([]any, 0, value.LengthInt())
+
+		for iterator := value.ElementIterator(); iterator.Next(); {
+			_, elementValue := iterator.Element()
+
+			element, err := convertValue(elementValue)
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, element)
+		}
+
+		return result, nil
+	case valueType.IsMapType(), valueType.IsObjectType():
+		result := make(map[string]any, value.LengthInt())
+
+		for iterator := value.ElementIterator(); iterator.Next(); {
+			keyValue, elementValue := iterator.Element()
+
+			element, err := convertValue(elementValue)
+			if err != nil {
+				return nil, err
+			}
+
+			result[keyValue.AsString()] = element
+		}
+
+		return result, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnsupportedValueType, valueType.FriendlyName())
+	}
+}
+
+// convertNumber converts integers which fit into an int64 to int64, and other numbers to float64.
+func convertNumber(number *big.Float) any {
+	if number.IsInt() {
+		if intValue, accuracy := number.Int64(); accuracy == big.Exact {
+			return intValue
+		}
+	}
+
+	floatValue, _ := number.Float64()
+
+	return floatValue
+}
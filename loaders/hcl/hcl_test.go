@@ -0,0 +1,220 @@
+package confiqhcl_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	confiqhcl "github.com/greencoda/confiq/loaders/hcl"
+	"github.com/stretchr/testify/suite"
+)
+
+var errFailedToRead = errors.New("failed to read")
+
+type brokenReader struct{}
+
+func (bR brokenReader) Read(_ []byte) (int, error) {
+	return 0, errFailedToRead
+}
+
+type HCLTestSuite struct {
+	suite.Suite
+
+	c *confiqhcl.Container
+}
+
+func Test_HCLTestSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(HCLTestSuite))
+}
+
+func (s *HCLTestSuite) SetupTest() {
+	s.c = confiqhcl.Load()
+	s.Require().NotNil(s.c)
+}
+
+func (s *HCLTestSuite) Test_Get() {
+	s.c.FromBytes([]byte("test_string = \"test\""))
+
+	s.Require().Len(s.c.Get(), 1)
+	s.Require().Empty(s.c.Errors())
+
+	valueMap, ok := s.c.Get()[0].(map[string]any)
+	s.Require().True(ok)
+
+	s.Contains(valueMap, "test_string")
+	s.Equal("test", valueMap["test_string"])
+}
+
+func (s *HCLTestSuite) Test_FromFile() {
+	s.c.FromFile("testdata/valid.hcl")
+
+	s.Len(s.c.Get(), 1)
+	s.Empty(s.c.Errors())
+}
+
+func (s *HCLTestSuite) Test_FromFile_InvalidPath() {
+	s.c.FromFile("testdata/nonexistent.hcl")
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqhcl.ErrCannotOpenHCLFile)
+}
+
+func (s *HCLTestSuite) Test_FromFile_Invalid() {
+	s.c.FromFile("testdata/invalid.hcl")
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqhcl.ErrCannotReadHCLBytes)
+}
+
+func (s *HCLTestSuite) Test_FromString() {
+	s.c.FromString("test_string = \"test\"")
+
+	s.Len(s.c.Get(), 1)
+	s.Empty(s.c.Errors())
+}
+
+func (s *HCLTestSuite) Test_FromString_Invalid() {
+	s.c.FromString("test_string =")
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqhcl.ErrCannotReadHCLBytes)
+}
+
+func (s *HCLTestSuite) Test_FromReader() {
+	s.c.FromReader(strings.NewReader("test_string = \"test\""))
+
+	s.Len(s.c.Get(), 1)
+	s.Empty(s.c.Errors())
+}
+
+func (s *HCLTestSuite) Test_FromReader_Invalid() {
+	s.c.FromReader(strings.NewReader("test_string ="))
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqhcl.ErrCannotReadHCLBytes)
+}
+
+func (s *HCLTestSuite) Test_FromReader_Nil() {
+	s.c.FromReader(nil)
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqhcl.ErrCannotReadHCLData)
+}
+
+func (s *HCLTestSuite) Test_FromReader_BrokenReader() {
+	s.c.FromReader(brokenReader{})
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqhcl.ErrCannotReadHCLData)
+}
+
+func (s *HCLTestSuite) Test_FromBytes() {
+	s.c.FromBytes([]byte("test_string = \"test\""))
+
+	s.Len(s.c.Get(), 1)
+	s.Empty(s.c.Errors())
+}
+
+func (s *HCLTestSuite) Test_FromBytes_Invalid() {
+	s.c.FromBytes([]byte("test_string ="))
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqhcl.ErrCannotReadHCLBytes)
+}
+
+func (s *HCLTestSuite) Test_Get_Values() {
+	s.c.FromString(`
+test_string = "test"
+test_int    = 42
+test_float  = 1.5
+test_bool   = true
+test_null   = null
+test_list   = ["a", "b"]
+test_object = { key = "value" }
+test_sum    = 1 + 2
+`)
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	s.Equal(map[string]any{
+		"test_string": "test",
+		"test_int":    int64(42),
+		"test_float":  1.5,
+		"test_bool":   true,
+		"test_null":   nil,
+		"test_list":   []any{"a", "b"},
+		"test_object": map[string]any{"key": "value"},
+		"test_sum":    int64(3),
+	}, s.c.Get()[0])
+}
+
+func (s *HCLTestSuite) Test_Get_Blocks() {
+	s.c.FromString(`
+database {
+  host = "localhost"
+}
+
+server "web" "primary" {
+  port = 80
+}
+
+server "api" "primary" {
+  port = 8080
+}
+
+listener {
+  port = 1
+}
+
+listener {
+  port = 2
+}
+`)
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	s.Equal(map[string]any{
+		"database": map[string]any{"host": "localhost"},
+		"server": map[string]any{
+			"web": map[string]any{"primary": map[string]any{"port": int64(80)}},
+			"api": map[string]any{"primary": map[string]any{"port": int64(8080)}},
+		},
+		"listener": []any{
+			map[string]any{"port": int64(1)},
+			map[string]any{"port": int64(2)},
+		},
+	}, s.c.Get()[0])
+}
+
+func (s *HCLTestSuite) Test_Get_ConflictingKeys() {
+	s.c.FromString(`
+server = "localhost"
+
+server {
+  port = 80
+}
+`)
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqhcl.ErrCannotReadHCLBytes)
+}
+
+func (s *HCLTestSuite) Test_Get_Variables() {
+	s.c.FromString(`test_string = var.value`)
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqhcl.ErrCannotReadHCLBytes)
+}
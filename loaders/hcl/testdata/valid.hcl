@@ -0,0 +1 @@
+test_string = "test"
@@ -0,0 +1,285 @@
+// Package confiqini allows confiq values to be loaded from INI format.
+package confiqini
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	ErrCannotOpenINIFile  = errors.New("cannot open INI file")
+	ErrCannotReadINIData  = errors.New("cannot read INI data")
+	ErrCannotReadINIBytes = errors.New("cannot read INI bytes")
+)
+
+var (
+	errInvalidSection    = errors.New("invalid section")
+	errInvalidKeyValue   = errors.New("invalid key-value pair")
+	errUnterminatedQuote = errors.New("unterminated quote")
+	errConflictingKeys   = errors.New("conflicting keys")
+)
+
+const (
+	sectionSeparator   = "."
+	continuationSuffix = "\\"
+	commentPrefixes    = ";#"
+)
+
+// Container is a struct that holds the loaded values.
+type Container struct {
+	values []any
+	errors []error
+}
+
+// Get returns the loaded INI values.
+func (c *Container) Get() []any {
+	return c.values
+}
+
+// Errors returns the errors that occurred during the loading process.
+func (c *Container) Errors() []error {
+	return c.errors
+}
+
+// Load creates an empty container, into which the INI values can be loaded.
+func Load() *Container {
+	container := new(Container)
+
+	return container
+}
+
+// FromFile loads an INI file from the given path.
+func (c *Container) FromFile(path string) *Container {
+	bytes, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		c.errors = append(c.errors, fmt.Errorf("%w: %w", ErrCannotOpenINIFile, err))
+
+		return c
+	}
+
+	c.readFromBytes(bytes)
+
+	return c
+}
+
+// FromString loads an INI file from the given string.
+func (c *Container) FromString(input string) *Container {
+	c.readFromBytes([]byte(input))
+
+	return c
+}
+
+// FromReader loads an INI file from a reader stream.
+func (c *Container) FromReader(reader io.Reader) *Container {
+	if reader == nil {
+		c.errors = append(c.errors, ErrCannotReadINIData)
+
+		return c
+	}
+
+	buffer := new(bytes.Buffer)
+
+	if _, err := buffer.ReadFrom(reader); err != nil {
+		c.errors = append(c.errors, fmt.Errorf("%w: %w", ErrCannotReadINIData, err))
+
+		return c
+	}
+
+	c.readFromBytes(buffer.Bytes())
+
+	return c
+}
+
+// FromBytes loads an INI file from the given bytes.
+func (c *Container) FromBytes(input []byte) *Container {
+	c.readFromBytes(input)
+
+	return c
+}
+
+func (c *Container) readFromBytes(input []byte) {
+	value, err := parse(input)
+	if err != nil {
+		c.errors = append(c.errors, fmt.Errorf("%w: %w", ErrCannotReadINIBytes, err))
+
+		return
+	}
+
+	c.values = append(c.values, value)
+}
+
+// parse parses the INI data into a map, in which the sections are nested maps.
+// The keys before the first section belong to the default section, and are placed at the root of the map.
+func parse(input []byte) (map[string]any, error) {
+	var (
+		result     = make(map[string]any)
+		section    = result
+		scanner    = bufio.NewScanner(bytes.NewReader(input))
+		lineNumber = 0
+	)
+
+	for scanner.Scan() {
+		lineNumber++
+
+		line := strings.TrimSpace(scanner.Text())
+
+		// lines ending with a backslash are continued on the next line
+		for strings.HasSuffix(line, continuationSuffix) && scanner.Scan() {
+			lineNumber++
+
+			line = strings.TrimSuffix(line, continuationSuffix) + strings.TrimSpace(scanner.Text())
+		}
+
+		if line == "" || strings.ContainsAny(line[:1], commentPrefixes) {
+			continue
+		}
+
+		if strings.HasPrefix(line, "[") {
+			sectionName, err := parseSectionName(line)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+
+			if section, err = getSection(result, sectionName); err != nil {
+				return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+			}
+
+			continue
+		}
+
+		key, value, err := parseKeyValue(line)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", lineNumber, err)
+		}
+
+		if _, ok := section[key].(map[string]any); ok {
+			return nil, fmt.Errorf("line %d: %w: %s", lineNumber, errConflictingKeys, key)
+		}
+
+		section[key] = value
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func parseSectionName(line string) (string, error) {
+	line = stripComment(line)
+
+	if !strings.HasSuffix(line, "]") {
+		return "", fmt.Errorf("%w: %s", errInvalidSection, line)
+	}
+
+	sectionName := strings.TrimSpace(line[1 : len(line)-1])
+	if sectionName == "" {
+		return "", fmt.Errorf("%w: %s", errInvalidSection, line)
+	}
+
+	return sectionName, nil
+}
+
+// getSection returns the map of the section, creating it and its parent sections if necessary.
+// Dotted section names, e.g. [db.replica], are nested in their parent sections.
+func getSection(result map[string]any, sectionName string) (map[string]any, error) {
+	section := result
+
+	for _, key := range strings.Split(sectionName, sectionSeparator) {
+		key = strings.TrimSpace(key)
+
+		if section[key] == nil {
+			section[key] = make(map[string]any)
+		}
+
+		childSection, ok := section[key].(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", errConflictingKeys, sectionName)
+		}
+
+		section = childSection
+	}
+
+	return section, nil
+}
+
+func parseKeyValue(line string) (string, string, error) {
+	separatorIndex := strings.IndexAny(line, "=:")
+	if separatorIndex <= 0 {
+		return "", "", fmt.Errorf("%w: %s", errInvalidKeyValue, line)
+	}
+
+	key := strings.TrimSpace(line[:separatorIndex])
+	if key == "" {
+		return "", "", fmt.Errorf("%w: %s", errInvalidKeyValue, line)
+	}
+
+	value, err := parseValue(strings.TrimSpace(line[separatorIndex+1:]))
+	if err != nil {
+		return "", "", err
+	}
+
+	return key, value, nil
+}
+
+// parseValue unquotes quoted values, and strips the inline comments of unquoted ones.
+func parseValue(value string) (string, error) {
+	if value == "" || (value[0] != '"' && value[0] != '\'') {
+		return stripComment(value), nil
+	}
+
+	var (
+		quote    = value[0]
+		unquoted strings.Builder
+	)
+
+	for i := 1; i < len(value); i++ {
+		switch {
+		case value[i] == quote:
+			if rest := strings.TrimSpace(value[i+1:]); rest != "" && !strings.ContainsAny(rest[:1], commentPrefixes) {
+				return "", fmt.Errorf("%w: %s", errInvalidKeyValue, value)
+			}
+
+			return unquoted.String(), nil
+		case value[i] == '\\' && quote == '"' && i+1 < len(value):
+			i++
+
+			unquoted.WriteByte(unescape(value[i]))
+		default:
+			unquoted.WriteByte(value[i])
+		}
+	}
+
+	return "", fmt.Errorf("%w: %s", errUnterminatedQuote, value)
+}
+
+func unescape(char byte) byte {
+	switch char {
+	case 'n':
+		return '\n'
+	case 't':
+		return '\t'
+	case 'r':
+		return '\r'
+	default:
+		return char
+	}
+}
+
+// stripComment removes the comment from the end of the line, which must be preceded by whitespace.
+func stripComment(line string) string {
+	for i := 1; i < len(line); i++ {
+		if strings.ContainsAny(line[i:i+1], commentPrefixes) && (line[i-1] == ' ' || line[i-1] == '\t') {
+			return strings.TrimSpace(line[:i])
+		}
+	}
+
+	return line
+}
@@ -0,0 +1,210 @@
+package confiqini_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	confiqini "github.com/greencoda/confiq/loaders/ini"
+	"github.com/stretchr/testify/suite"
+)
+
+var errFailedToRead = errors.New("failed to read")
+
+type brokenReader struct{}
+
+func (bR brokenReader) Read(_ []byte) (int, error) {
+	return 0, errFailedToRead
+}
+
+type INITestSuite struct {
+	suite.Suite
+
+	c *confiqini.Container
+}
+
+func Test_INITestSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(INITestSuite))
+}
+
+func (s *INITestSuite) SetupTest() {
+	s.c = confiqini.Load()
+	s.Require().NotNil(s.c)
+}
+
+func (s *INITestSuite) Test_Get() {
+	s.c.FromBytes([]byte("test_string = test"))
+
+	s.Require().Len(s.c.Get(), 1)
+	s.Require().Empty(s.c.Errors())
+
+	valueMap, ok := s.c.Get()[0].(map[string]any)
+	s.Require().True(ok)
+
+	s.Contains(valueMap, "test_string")
+	s.Equal("test", valueMap["test_string"])
+}
+
+func (s *INITestSuite) Test_FromFile() {
+	s.c.FromFile("testdata/valid.ini")
+
+	s.Len(s.c.Get(), 1)
+	s.Empty(s.c.Errors())
+}
+
+func (s *INITestSuite) Test_FromFile_InvalidPath() {
+	s.c.FromFile("testdata/nonexistent.ini")
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqini.ErrCannotOpenINIFile)
+}
+
+func (s *INITestSuite) Test_FromFile_Invalid() {
+	s.c.FromFile("testdata/invalid.ini")
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqini.ErrCannotReadINIBytes)
+}
+
+func (s *INITestSuite) Test_FromString() {
+	s.c.FromString("test_string = test")
+
+	s.Len(s.c.Get(), 1)
+	s.Empty(s.c.Errors())
+}
+
+func (s *INITestSuite) Test_FromString_Invalid() {
+	s.c.FromString("[test_section")
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqini.ErrCannotReadINIBytes)
+}
+
+func (s *INITestSuite) Test_FromReader() {
+	s.c.FromReader(strings.NewReader("test_string = test"))
+
+	s.Len(s.c.Get(), 1)
+	s.Empty(s.c.Errors())
+}
+
+func (s *INITestSuite) Test_FromReader_Invalid() {
+	s.c.FromReader(strings.NewReader("[test_section"))
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqini.ErrCannotReadINIBytes)
+}
+
+func (s *INITestSuite) Test_FromReader_Nil() {
+	s.c.FromReader(nil)
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqini.ErrCannotReadINIData)
+}
+
+func (s *INITestSuite) Test_FromReader_BrokenReader() {
+	s.c.FromReader(brokenReader{})
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqini.ErrCannotReadINIData)
+}
+
+func (s *INITestSuite) Test_FromBytes() {
+	s.c.FromBytes([]byte("test_string = test"))
+
+	s.Len(s.c.Get(), 1)
+	s.Empty(s.c.Errors())
+}
+
+func (s *INITestSuite) Test_FromBytes_Invalid() {
+	s.c.FromBytes([]byte("[test_section"))
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqini.ErrCannotReadINIBytes)
+}
+
+func (s *INITestSuite) Test_Get_Sections() {
+	s.c.FromString(`
+; the default section
+name = service
+
+[server]
+host = localhost
+port: 8080
+
+[db.replica]
+host = replica.local # the read replica
+
+[db]
+host = primary.local
+`)
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	s.Equal(map[string]any{
+		"name": "service",
+		"server": map[string]any{
+			"host": "localhost",
+			"port": "8080",
+		},
+		"db": map[string]any{
+			"host": "primary.local",
+			"replica": map[string]any{
+				"host": "replica.local",
+			},
+		},
+	}, s.c.Get()[0])
+}
+
+func (s *INITestSuite) Test_Get_Values() {
+	s.c.FromString(`
+# comments are skipped
+double_quoted = "a \"quoted\" value ; not a comment"
+single_quoted = 'C:\path'
+escaped       = "line\nbreak"
+empty         =
+inline        = value;not a comment ; a comment
+continued     = first \
+                second \
+                third
+`)
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	s.Equal(map[string]any{
+		"double_quoted": `a "quoted" value ; not a comment`,
+		"single_quoted": `C:\path`,
+		"escaped":       "line\nbreak",
+		"empty":         "",
+		"inline":        "value;not a comment",
+		"continued":     "first second third",
+	}, s.c.Get()[0])
+}
+
+func (s *INITestSuite) Test_Get_Invalid() {
+	for _, input := range []string{
+		"[]",
+		"missing separator",
+		"= value",
+		`unterminated = "value`,
+		`trailing = "value" text`,
+		"section = value\n[section]",
+		"[section]\n[section.child]\n[section]\nchild = value",
+	} {
+		container := confiqini.Load().FromString(input)
+
+		s.Empty(container.Get(), input)
+		s.Require().Len(container.Errors(), 1, input)
+		s.ErrorIs(container.Errors()[0], confiqini.ErrCannotReadINIBytes, input)
+	}
+}
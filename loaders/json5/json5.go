@@ -0,0 +1,101 @@
+// Package confiqjson5 allows confiq values to be loaded from JSON5 format,
+// which is a superset of JSON that also covers JSONC (JSON with comments).
+package confiqjson5
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+var (
+	ErrCannotOpenJSON5File  = errors.New("cannot open JSON5 file")
+	ErrCannotReadJSON5Data  = errors.New("cannot read JSON5 data")
+	ErrCannotReadJSON5Bytes = errors.New("cannot read JSON5 bytes")
+)
+
+// Container is a struct that holds the loaded values.
+type Container struct {
+	values []any
+	errors []error
+}
+
+// Get returns the loaded JSON5 values.
+func (c *Container) Get() []any {
+	return c.values
+}
+
+// Errors returns the errors that occurred during the loading process.
+func (c *Container) Errors() []error {
+	return c.errors
+}
+
+// Load creates an empty container, into which the JSON5 values can be loaded.
+func Load() *Container {
+	container := new(Container)
+
+	return container
+}
+
+// FromFile loads a JSON5 file from the given path.
+func (c *Container) FromFile(path string) *Container {
+	bytes, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		c.errors = append(c.errors, fmt.Errorf("%w: %w", ErrCannotOpenJSON5File, err))
+
+		return c
+	}
+
+	c.readFromBytes(bytes)
+
+	return c
+}
+
+// FromString loads a JSON5 file from the given string.
+func (c *Container) FromString(input string) *Container {
+	c.readFromBytes([]byte(input))
+
+	return c
+}
+
+// FromReader loads a JSON5 file from a reader stream.
+func (c *Container) FromReader(reader io.Reader) *Container {
+	if reader == nil {
+		c.errors = append(c.errors, ErrCannotReadJSON5Data)
+
+		return c
+	}
+
+	buffer := new(bytes.Buffer)
+
+	if _, err := buffer.ReadFrom(reader); err != nil {
+		c.errors = append(c.errors, fmt.Errorf("%w: %w", ErrCannotReadJSON5Data, err))
+
+		return c
+	}
+
+	c.readFromBytes(buffer.Bytes())
+
+	return c
+}
+
+// FromBytes loads a JSON5 file from the given bytes.
+func (c *Container) FromBytes(input []byte) *Container {
+	c.readFromBytes(input)
+
+	return c
+}
+
+func (c *Container) readFromBytes(input []byte) {
+	value, err := parse(input)
+	if err != nil {
+		c.errors = append(c.errors, fmt.Errorf("%w: %w", ErrCannotReadJSON5Bytes, err))
+
+		return
+	}
+
+	c.values = append(c.values, value)
+}
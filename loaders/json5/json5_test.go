@@ -0,0 +1,212 @@
+package confiqjson5_test
+
+import (
+	"errors"
+	"math"
+	"strings"
+	"testing"
+
+	confiqjson5 "github.com/greencoda/confiq/loaders/json5"
+	"github.com/stretchr/testify/suite"
+)
+
+var errFailedToRead = errors.New("failed to read")
+
+type brokenReader struct{}
+
+func (bR brokenReader) Read(_ []byte) (int, error) {
+	return 0, errFailedToRead
+}
+
+type JSON5TestSuite struct {
+	suite.Suite
+
+	c *confiqjson5.Container
+}
+
+func Test_JSON5TestSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(JSON5TestSuite))
+}
+
+func (s *JSON5TestSuite) SetupTest() {
+	s.c = confiqjson5.Load()
+	s.Require().NotNil(s.c)
+}
+
+func (s *JSON5TestSuite) Test_Get() {
+	s.c.FromBytes([]byte("{\"test_string\":\"test\"}"))
+
+	s.Require().Len(s.c.Get(), 1)
+	s.Require().Empty(s.c.Errors())
+
+	valueMap, ok := s.c.Get()[0].(map[string]any)
+	s.Require().True(ok)
+
+	s.Contains(valueMap, "test_string")
+	s.Equal("test", valueMap["test_string"])
+}
+
+func (s *JSON5TestSuite) Test_FromFile() {
+	s.c.FromFile("testdata/valid.json5")
+
+	s.Len(s.c.Get(), 1)
+	s.Empty(s.c.Errors())
+}
+
+func (s *JSON5TestSuite) Test_FromFile_InvalidPath() {
+	s.c.FromFile("testdata/nonexistent.json5")
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqjson5.ErrCannotOpenJSON5File)
+}
+
+func (s *JSON5TestSuite) Test_FromFile_Invalid() {
+	s.c.FromFile("testdata/invalid.json5")
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqjson5.ErrCannotReadJSON5Bytes)
+}
+
+func (s *JSON5TestSuite) Test_FromString() {
+	s.c.FromString("{\"test_string\":\"test\"}")
+
+	s.Len(s.c.Get(), 1)
+	s.Empty(s.c.Errors())
+}
+
+func (s *JSON5TestSuite) Test_FromString_Invalid() {
+	s.c.FromString("{")
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqjson5.ErrCannotReadJSON5Bytes)
+}
+
+func (s *JSON5TestSuite) Test_FromReader() {
+	s.c.FromReader(strings.NewReader("{\"test_string\":\"test\"}"))
+
+	s.Len(s.c.Get(), 1)
+	s.Empty(s.c.Errors())
+}
+
+func (s *JSON5TestSuite) Test_FromReader_Invalid() {
+	s.c.FromReader(strings.NewReader("{"))
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqjson5.ErrCannotReadJSON5Bytes)
+}
+
+func (s *JSON5TestSuite) Test_FromReader_Nil() {
+	s.c.FromReader(nil)
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqjson5.ErrCannotReadJSON5Data)
+}
+
+func (s *JSON5TestSuite) Test_FromReader_BrokenReader() {
+	s.c.FromReader(brokenReader{})
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqjson5.ErrCannotReadJSON5Data)
+}
+
+func (s *JSON5TestSuite) Test_FromBytes() {
+	s.c.FromBytes([]byte("{\"test_string\":\"test\"}"))
+
+	s.Len(s.c.Get(), 1)
+	s.Empty(s.c.Errors())
+}
+
+func (s *JSON5TestSuite) Test_FromBytes_Invalid() {
+	s.c.FromBytes([]byte("{"))
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqjson5.ErrCannotReadJSON5Bytes)
+}
+
+func (s *JSON5TestSuite) Test_Get_JSON5() {
+	s.c.FromString(`
+// tuning choices are explained in comments
+{
+	/* unquoted keys */
+	unquoted: 'single quoted',
+	"quoted": "double \"quoted\"",
+	$special_key1: true,
+	hex: 0xFF,
+	leadingDecimal: .5,
+	trailingDecimal: 5.,
+	positive: +1,
+	negative: -1e3,
+	infinity: -Infinity,
+	nothing: null,
+	escapes: '\x41é😀\
+continued',
+	list: [1, 2, 3,],
+	nested: {
+		key: 'value',
+	},
+}
+`)
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	s.Equal(map[string]any{
+		"unquoted":        "single quoted",
+		"quoted":          `double "quoted"`,
+		"$special_key1":   true,
+		"hex":             float64(255),
+		"leadingDecimal":  0.5,
+		"trailingDecimal": float64(5),
+		"positive":        float64(1),
+		"negative":        float64(-1000),
+		"infinity":        math.Inf(-1),
+		"nothing":         nil,
+		"escapes":         "Aé😀continued",
+		"list":            []any{float64(1), float64(2), float64(3)},
+		"nested":          map[string]any{"key": "value"},
+	}, s.c.Get()[0])
+}
+
+func (s *JSON5TestSuite) Test_Get_JSON5_LargeInput() {
+	const elementCount = 100000
+
+	s.c.FromString("[" + strings.Repeat("/* element */ 1, // trailing comment\n", elementCount) + "]")
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	list, ok := s.c.Get()[0].([]any)
+	s.Require().True(ok)
+	s.Len(list, elementCount)
+}
+
+func (s *JSON5TestSuite) Test_Get_JSON5_Invalid() {
+	for _, input := range []string{
+		"",
+		"{key: 'value'",
+		"{key 'value'}",
+		"{1key: 'value'}",
+		"['unterminated]",
+		"[1, 2] /* unterminated comment",
+		"[1,, 2]",
+		"[01]",
+		"[1-2]",
+		"['\\1']",
+		"[1] [2]",
+	} {
+		container := confiqjson5.Load().FromString(input)
+
+		s.Empty(container.Get(), input)
+		s.Require().Len(container.Errors(), 1, input)
+		s.ErrorIs(container.Errors()[0], confiqjson5.ErrCannotReadJSON5Bytes, input)
+	}
+}
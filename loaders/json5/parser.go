@@ -0,0 +1,446 @@
+package confiqjson5
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+var (
+	errUnexpectedEndOfInput  = errors.New("unexpected end of input")
+	errUnexpectedCharacter   = errors.New("unexpected character")
+	errUnterminatedComment   = errors.New("unterminated comment")
+	errUnterminatedString    = errors.New("unterminated string")
+	errInvalidEscapeSequence = errors.New("invalid escape sequence")
+	errInvalidNumber         = errors.New("invalid number")
+)
+
+// parser parses JSON5 into the same values as encoding/json does for JSON:
+// objects into map[string]any, arrays into []any, numbers into float64, as well as strings, bools and nil.
+type parser struct {
+	input []byte
+	pos   int
+}
+
+func parse(input []byte) (any, error) {
+	p := &parser{
+		input: input,
+		pos:   0,
+	}
+
+	value, err := p.parseValue()
+	if err != nil {
+		return nil, p.wrapError(err)
+	}
+
+	if err := p.skipWhitespace(); err != nil {
+		return nil, p.wrapError(err)
+	}
+
+	if p.pos < len(p.input) {
+		return nil, p.wrapError(fmt.Errorf("%w: %q", errUnexpectedCharacter, p.input[p.pos]))
+	}
+
+	return value, nil
+}
+
+// wrapError adds the line and column of the current position to the error.
+func (p *parser) wrapError(err error) error {
+	var (
+		consumed = p.input[:min(p.pos, len(p.input))]
+		line     = bytes.Count(consumed, []byte("\n")) + 1
+		column   = len(consumed) - bytes.LastIndexByte(consumed, '\n')
+	)
+
+	return fmt.Errorf("line %d, column %d: %w", line, column, err)
+}
+
+// skipWhitespace skips the whitespace and the comments before the next token.
+func (p *parser) skipWhitespace() error {
+	for p.pos < len(p.input) {
+		char, size := utf8.DecodeRune(p.input[p.pos:])
+
+		switch {
+		case unicode.IsSpace(char) || char == '\uFEFF':
+			p.pos += size
+		case p.hasPrefix("//"):
+			for p.pos < len(p.input) && p.input[p.pos] != '\n' {
+				p.pos++
+			}
+		case p.hasPrefix("/*"):
+			end := bytes.Index(p.input[p.pos+2:], []byte("*/"))
+			if end < 0 {
+				return errUnterminatedComment
+			}
+
+			p.pos += end + 4
+		default:
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (p *parser) hasPrefix(prefix string) bool {
+	return bytes.HasPrefix(p.input[p.pos:], []byte(prefix))
+}
+
+func (p *parser) parseValue() (any, error) {
+	if err := p.skipWhitespace(); err != nil {
+		return nil, err
+	}
+
+	if p.pos >= len(p.input) {
+		return nil, errUnexpectedEndOfInput
+	}
+
+	switch char := p.input[p.pos]; {
+	case char == '{':
+		return p.parseObject()
+	case char == '[':
+		return p.parseArray()
+	case char == '"' || char == '\'':
+		return p.parseString()
+	case p.hasPrefix("true"):
+		p.pos += len("true")
+
+		return true, nil
+	case p.hasPrefix("false"):
+		p.pos += len("false")
+
+		return false, nil
+	case p.hasPrefix("null"):
+		p.pos += len("null")
+
+		return nil, nil
+	default:
+		return p.parseNumber()
+	}
+}
+
+func (p *parser) parseObject() (map[string]any, error) {
+	object := make(map[string]any)
+
+	// skip the opening brace
+	p.pos++
+
+	for {
+		if err := p.skipWhitespace(); err != nil {
+			return nil, err
+		}
+
+		if p.pos >= len(p.input) {
+			return nil, errUnexpectedEndOfInput
+		}
+
+		// the closing brace may follow a trailing comma
+		if p.input[p.pos] == '}' {
+			p.pos++
+
+			return object, nil
+		}
+
+		key, err := p.parseKey()
+		if err != nil {
+			return nil, err
+		}
+
+		if err := p.expect(':'); err != nil {
+			return nil, err
+		}
+
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+
+		object[key] = value
+
+		if done, err := p.parseSeparator('}'); err != nil || done {
+			return object, err
+		}
+	}
+}
+
+func (p *parser) parseArray() ([]any, error) {
+	array := make([]any, 0)
+
+	// skip the opening bracket
+	p.pos++
+
+	for {
+		if err := p.skipWhitespace(); err != nil {
+			return nil, err
+		}
+
+		if p.pos >= len(p.input) {
+			return nil, errUnexpectedEndOfInput
+		}
+
+		// the closing bracket may follow a trailing comma
+		if p.input[p.pos] == ']' {
+			p.pos++
+
+			return array, nil
+		}
+
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+
+		array = append(array, value)
+
+		if done, err := p.parseSeparator(']'); err != nil || done {
+			return array, err
+		}
+	}
+}
+
+// parseSeparator consumes the comma after an element, or the closing character, in which case it reports that the container is done.
+func (p *parser) parseSeparator(closingChar byte) (bool, error) {
+	if err := p.skipWhitespace(); err != nil {
+		return false, err
+	}
+
+	if p.pos >= len(p.input) {
+		return false, errUnexpectedEndOfInput
+	}
+
+	switch p.input[p.pos] {
+	case ',':
+		p.pos++
+
+		return false, nil
+	case closingChar:
+		p.pos++
+
+		return true, nil
+	default:
+		return false, fmt.Errorf("%w: %q", errUnexpectedCharacter, p.input[p.pos])
+	}
+}
+
+func (p *parser) expect(char byte) error {
+	if err := p.skipWhitespace(); err != nil {
+		return err
+	}
+
+	if p.pos >= len(p.input) {
+		return errUnexpectedEndOfInput
+	}
+
+	if p.input[p.pos] != char {
+		return fmt.Errorf("%w: %q", errUnexpectedCharacter, p.input[p.pos])
+	}
+
+	p.pos++
+
+	return nil
+}
+
+// parseKey parses the key of an object member, which may be a string or an unquoted identifier.
+func (p *parser) parseKey() (string, error) {
+	if char := p.input[p.pos]; char == '"' || char == '\'' {
+		return p.parseString()
+	}
+
+	start := p.pos
+
+	for p.pos < len(p.input) {
+		char, size := utf8.DecodeRune(p.input[p.pos:])
+		if !isIdentifierRune(char, p.pos == start) {
+			break
+		}
+
+		p.pos += size
+	}
+
+	if p.pos == start {
+		return "", fmt.Errorf("%w: %q", errUnexpectedCharacter, p.input[p.pos])
+	}
+
+	return string(p.input[start:p.pos]), nil
+}
+
+func isIdentifierRune(char rune, first bool) bool {
+	if char == '$' || char == '_' || unicode.IsLetter(char) {
+		return true
+	}
+
+	return !first && (unicode.IsDigit(char) || unicode.Is(unicode.Mn, char) || unicode.Is(unicode.Mc, char) || unicode.Is(unicode.Pc, char))
+}
+
+// parseString parses a double- or single-quoted string, which may be continued on the next line after a backslash.
+func (p *parser) parseString() (string, error) {
+	var (
+		quote   = p.input[p.pos]
+		builder strings.Builder
+	)
+
+	p.pos++
+
+	for p.pos < len(p.input) {
+		char := p.input[p.pos]
+
+		switch {
+		case char == quote:
+			p.pos++
+
+			return builder.String(), nil
+		case char == '\n':
+			return "", errUnterminatedString
+		case char == '\\':
+			if err := p.parseEscapeSequence(&builder); err != nil {
+				return "", err
+			}
+		default:
+			builder.WriteByte(char)
+			p.pos++
+		}
+	}
+
+	return "", errUnterminatedString
+}
+
+func (p *parser) parseEscapeSequence(builder *strings.Builder) error {
+	// skip the backslash
+	p.pos++
+
+	if p.pos >= len(p.input) {
+		return errUnterminatedString
+	}
+
+	char := p.input[p.pos]
+	p.pos++
+
+	switch char {
+	case 'b':
+		builder.WriteByte('\b')
+	case 'f':
+		builder.WriteByte('\f')
+	case 'n':
+		builder.WriteByte('\n')
+	case 'r':
+		builder.WriteByte('\r')
+	case 't':
+		builder.WriteByte('\t')
+	case 'v':
+		builder.WriteByte('\v')
+	case '0':
+		builder.WriteByte(0)
+	case '\r':
+		// line continuations are omitted from the string
+		if p.pos < len(p.input) && p.input[p.pos] == '\n' {
+			p.pos++
+		}
+	case '\n':
+	case 'x':
+		codePoint, err := p.parseHex(2)
+		if err != nil {
+			return err
+		}
+
+		builder.WriteRune(codePoint)
+	case 'u':
+		codePoint, err := p.parseHex(4)
+		if err != nil {
+			return err
+		}
+
+		// characters outside of the basic multilingual plane are escaped as surrogate pairs
+		if utf16.IsSurrogate(codePoint) && p.hasPrefix("\\u") {
+			p.pos += 2
+
+			lowSurrogate, err := p.parseHex(4)
+			if err != nil {
+				return err
+			}
+
+			codePoint = utf16.DecodeRune(codePoint, lowSurrogate)
+		}
+
+		builder.WriteRune(codePoint)
+	default:
+		if char >= '1' && char <= '9' {
+			return fmt.Errorf("%w: \\%c", errInvalidEscapeSequence, char)
+		}
+
+		builder.WriteByte(char)
+	}
+
+	return nil
+}
+
+func (p *parser) parseHex(length int) (rune, error) {
+	if p.pos+length > len(p.input) {
+		return 0, errUnexpectedEndOfInput
+	}
+
+	codePoint, err := strconv.ParseUint(string(p.input[p.pos:p.pos+length]), 16, 32)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %w", errInvalidEscapeSequence, err)
+	}
+
+	p.pos += length
+
+	return rune(codePoint), nil
+}
+
+// parseNumber parses decimal and hexadecimal numbers, which may have a leading sign or decimal point,
+// as well as Infinity and NaN.
+func (p *parser) parseNumber() (float64, error) {
+	start := p.pos
+
+	for p.pos < len(p.input) && strings.IndexByte("+-0123456789abcdefABCDEFxX.InfinityNaN", p.input[p.pos]) >= 0 {
+		p.pos++
+	}
+
+	literal := string(p.input[start:p.pos])
+	if literal == "" {
+		return 0, fmt.Errorf("%w: %q", errUnexpectedCharacter, p.input[p.pos])
+	}
+
+	sign, unsignedLiteral := 1.0, literal
+
+	switch literal[0] {
+	case '-':
+		sign, unsignedLiteral = -1, literal[1:]
+	case '+':
+		unsignedLiteral = literal[1:]
+	}
+
+	switch {
+	case unsignedLiteral == "Infinity":
+		return math.Inf(int(sign)), nil
+	case unsignedLiteral == "NaN":
+		return math.NaN(), nil
+	case strings.HasPrefix(unsignedLiteral, "0x"), strings.HasPrefix(unsignedLiteral, "0X"):
+		number, err := strconv.ParseUint(unsignedLiteral[2:], 16, 64)
+		if err != nil {
+			return 0, fmt.Errorf("%w: %s", errInvalidNumber, literal)
+		}
+
+		return sign * float64(number), nil
+	case strings.HasPrefix(unsignedLiteral, "0") && len(unsignedLiteral) > 1 && unsignedLiteral[1] >= '0' && unsignedLiteral[1] <= '9':
+		// leading zeros are not allowed, as they would make the number look octal
+		return 0, fmt.Errorf("%w: %s", errInvalidNumber, literal)
+	case strings.ContainsAny(unsignedLiteral, "+-") && !strings.ContainsAny(unsignedLiteral, "eE"),
+		strings.ContainsAny(unsignedLiteral, "xXabcdfABCDFInityN_"):
+		return 0, fmt.Errorf("%w: %s", errInvalidNumber, literal)
+	}
+
+	number, err := strconv.ParseFloat(unsignedLiteral, 64)
+	if err != nil {
+		return 0, fmt.Errorf("%w: %s", errInvalidNumber, literal)
+	}
+
+	return sign * number, nil
+}
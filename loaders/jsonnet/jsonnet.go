@@ -0,0 +1,166 @@
+// Package confiqjsonnet allows confiq values to be loaded from Jsonnet format,
+// by evaluating the Jsonnet programs into JSON values.
+package confiqjsonnet
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/google/go-jsonnet"
+)
+
+var (
+	ErrCannotOpenJsonnetFile  = errors.New("cannot open Jsonnet file")
+	ErrCannotReadJsonnetData  = errors.New("cannot read Jsonnet data")
+	ErrCannotReadJsonnetBytes = errors.New("cannot read Jsonnet bytes")
+)
+
+// snippetFilename is the name under which the Jsonnet programs not loaded from files are evaluated,
+// which makes their relative imports resolved from the working directory.
+const snippetFilename = "<snippet>"
+
+// Container is a struct that holds the loaded values.
+type Container struct {
+	values []any
+	errors []error
+	vm     *jsonnet.VM
+}
+
+// Get returns the loaded Jsonnet values.
+func (c *Container) Get() []any {
+	return c.values
+}
+
+// Errors returns the errors that occurred during the loading process.
+func (c *Container) Errors() []error {
+	return c.errors
+}
+
+// Load creates an empty container, into which the Jsonnet values can be loaded.
+func Load() *Container {
+	container := &Container{
+		values: nil,
+		errors: nil,
+		vm:     jsonnet.MakeVM(),
+	}
+
+	return container
+}
+
+// WithExtVar sets an external string variable, which can be accessed with std.extVar(name) by the Jsonnet programs loaded afterwards.
+func (c *Container) WithExtVar(name, value string) *Container {
+	c.vm.ExtVar(name, value)
+
+	return c
+}
+
+// WithExtCode sets an external variable from Jsonnet code, which can be accessed with std.extVar(name) by the Jsonnet programs loaded afterwards.
+func (c *Container) WithExtCode(name, code string) *Container {
+	c.vm.ExtCode(name, code)
+
+	return c
+}
+
+// WithTLAVar sets a top-level string argument, which is passed to the Jsonnet programs loaded afterwards if they evaluate to a function.
+func (c *Container) WithTLAVar(name, value string) *Container {
+	c.vm.TLAVar(name, value)
+
+	return c
+}
+
+// WithTLACode sets a top-level argument from Jsonnet code, which is passed to the Jsonnet programs loaded afterwards if they evaluate to a function.
+func (c *Container) WithTLACode(name, code string) *Container {
+	c.vm.TLACode(name, code)
+
+	return c
+}
+
+// WithImportPaths sets the library paths, in which the imports are searched for if they are not found relative to the importing file.
+func (c *Container) WithImportPaths(paths ...string) *Container {
+	c.vm.Importer(&jsonnet.FileImporter{
+		JPaths: paths,
+	})
+
+	return c
+}
+
+// FromFile loads a Jsonnet file from the given path, resolving its relative imports from the directory of the file.
+func (c *Container) FromFile(path string) *Container {
+	bytes, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		c.errors = append(c.errors, fmt.Errorf("%w: %w", ErrCannotOpenJsonnetFile, err))
+
+		return c
+	}
+
+	c.readFromBytes(path, bytes)
+
+	return c
+}
+
+// FromString loads a Jsonnet file from the given string.
+func (c *Container) FromString(input string) *Container {
+	c.readFromBytes(snippetFilename, []byte(input))
+
+	return c
+}
+
+// FromReader loads a Jsonnet file from a reader stream.
+func (c *Container) FromReader(reader io.Reader) *Container {
+	if reader == nil {
+		c.errors = append(c.errors, ErrCannotReadJsonnetData)
+
+		return c
+	}
+
+	buffer := new(bytes.Buffer)
+
+	if _, err := buffer.ReadFrom(reader); err != nil {
+		c.errors = append(c.errors, fmt.Errorf("%w: %w", ErrCannotReadJsonnetData, err))
+
+		return c
+	}
+
+	c.readFromBytes(snippetFilename, buffer.Bytes())
+
+	return c
+}
+
+// FromBytes loads a Jsonnet file from the given bytes.
+func (c *Container) FromBytes(input []byte) *Container {
+	c.readFromBytes(snippetFilename, input)
+
+	return c
+}
+
+func (c *Container) readFromBytes(filename string, input []byte) {
+	// the filename of the snippet is used to resolve its relative imports
+	node, err := jsonnet.SnippetToAST(filename, string(input))
+	if err != nil {
+		c.errors = append(c.errors, fmt.Errorf("%w: %w", ErrCannotReadJsonnetBytes, err))
+
+		return
+	}
+
+	output, err := c.vm.Evaluate(node)
+	if err != nil {
+		c.errors = append(c.errors, fmt.Errorf("%w: %w", ErrCannotReadJsonnetBytes, err))
+
+		return
+	}
+
+	var value any
+
+	if err := json.Unmarshal([]byte(output), &value); err != nil {
+		c.errors = append(c.errors, fmt.Errorf("%w: %w", ErrCannotReadJsonnetBytes, err))
+
+		return
+	}
+
+	c.values = append(c.values, value)
+}
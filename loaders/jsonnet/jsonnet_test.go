@@ -0,0 +1,201 @@
+package confiqjsonnet_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	confiqjsonnet "github.com/greencoda/confiq/loaders/jsonnet"
+	"github.com/stretchr/testify/suite"
+)
+
+var errFailedToRead = errors.New("failed to read")
+
+type brokenReader struct{}
+
+func (bR brokenReader) Read(_ []byte) (int, error) {
+	return 0, errFailedToRead
+}
+
+type JsonnetTestSuite struct {
+	suite.Suite
+
+	c *confiqjsonnet.Container
+}
+
+func Test_JsonnetTestSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(JsonnetTestSuite))
+}
+
+func (s *JsonnetTestSuite) SetupTest() {
+	s.c = confiqjsonnet.Load()
+	s.Require().NotNil(s.c)
+}
+
+func (s *JsonnetTestSuite) Test_Get() {
+	s.c.FromBytes([]byte("{\"test_string\":\"test\"}"))
+
+	s.Require().Len(s.c.Get(), 1)
+	s.Require().Empty(s.c.Errors())
+
+	valueMap, ok := s.c.Get()[0].(map[string]any)
+	s.Require().True(ok)
+
+	s.Contains(valueMap, "test_string")
+	s.Equal("test", valueMap["test_string"])
+}
+
+func (s *JsonnetTestSuite) Test_FromFile() {
+	s.c.FromFile("testdata/valid.jsonnet")
+
+	s.Len(s.c.Get(), 1)
+	s.Empty(s.c.Errors())
+}
+
+func (s *JsonnetTestSuite) Test_FromFile_InvalidPath() {
+	s.c.FromFile("testdata/nonexistent.jsonnet")
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqjsonnet.ErrCannotOpenJsonnetFile)
+}
+
+func (s *JsonnetTestSuite) Test_FromFile_Invalid() {
+	s.c.FromFile("testdata/invalid.jsonnet")
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqjsonnet.ErrCannotReadJsonnetBytes)
+}
+
+func (s *JsonnetTestSuite) Test_FromString() {
+	s.c.FromString("{\"test_string\":\"test\"}")
+
+	s.Len(s.c.Get(), 1)
+	s.Empty(s.c.Errors())
+}
+
+func (s *JsonnetTestSuite) Test_FromString_Invalid() {
+	s.c.FromString("{")
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqjsonnet.ErrCannotReadJsonnetBytes)
+}
+
+func (s *JsonnetTestSuite) Test_FromReader() {
+	s.c.FromReader(strings.NewReader("{\"test_string\":\"test\"}"))
+
+	s.Len(s.c.Get(), 1)
+	s.Empty(s.c.Errors())
+}
+
+func (s *JsonnetTestSuite) Test_FromReader_Invalid() {
+	s.c.FromReader(strings.NewReader("{"))
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqjsonnet.ErrCannotReadJsonnetBytes)
+}
+
+func (s *JsonnetTestSuite) Test_FromReader_Nil() {
+	s.c.FromReader(nil)
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqjsonnet.ErrCannotReadJsonnetData)
+}
+
+func (s *JsonnetTestSuite) Test_FromReader_BrokenReader() {
+	s.c.FromReader(brokenReader{})
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqjsonnet.ErrCannotReadJsonnetData)
+}
+
+func (s *JsonnetTestSuite) Test_FromBytes() {
+	s.c.FromBytes([]byte("{\"test_string\":\"test\"}"))
+
+	s.Len(s.c.Get(), 1)
+	s.Empty(s.c.Errors())
+}
+
+func (s *JsonnetTestSuite) Test_FromBytes_Invalid() {
+	s.c.FromBytes([]byte("{"))
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqjsonnet.ErrCannotReadJsonnetBytes)
+}
+
+func (s *JsonnetTestSuite) Test_Get_Jsonnet() {
+	s.c.FromFile("testdata/valid.jsonnet")
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	s.Equal(map[string]any{
+		"test_string": "test",
+		"port":        float64(8080),
+	}, s.c.Get()[0])
+}
+
+func (s *JsonnetTestSuite) Test_Get_Jsonnet_ExtVars() {
+	s.c.
+		WithExtVar("environment", "prod").
+		WithExtCode("replicas", "1 + 2").
+		FromString(`{
+	environment: std.extVar('environment'),
+	replicas: std.extVar('replicas'),
+	hosts: ['db-%d.%s' % [i, self.environment] for i in std.range(1, self.replicas)],
+}`)
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	s.Equal(map[string]any{
+		"environment": "prod",
+		"replicas":    float64(3),
+		"hosts":       []any{"db-1.prod", "db-2.prod", "db-3.prod"},
+	}, s.c.Get()[0])
+}
+
+func (s *JsonnetTestSuite) Test_Get_Jsonnet_ExtVars_Missing() {
+	s.c.FromString("{ environment: std.extVar('environment') }")
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqjsonnet.ErrCannotReadJsonnetBytes)
+}
+
+func (s *JsonnetTestSuite) Test_Get_Jsonnet_TLAs() {
+	s.c.
+		WithTLAVar("environment", "prod").
+		WithTLACode("debug", "false").
+		FromString("function(environment, debug) { environment: environment, debug: debug }")
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	s.Equal(map[string]any{
+		"environment": "prod",
+		"debug":       false,
+	}, s.c.Get()[0])
+}
+
+func (s *JsonnetTestSuite) Test_Get_Jsonnet_ImportPaths() {
+	s.c.
+		WithImportPaths("testdata/lib").
+		FromString("(import 'shared.libsonnet') { test_string: 'test' }")
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	s.Equal(map[string]any{
+		"name":        "shared",
+		"test_string": "test",
+	}, s.c.Get()[0])
+}
@@ -0,0 +1,331 @@
+// Package confiqproperties allows confiq values to be loaded from Java .properties format.
+package confiqproperties
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf16"
+
+	"github.com/greencoda/confiq"
+)
+
+var (
+	ErrCannotOpenPropertiesFile  = errors.New("cannot open properties file")
+	ErrCannotReadPropertiesData  = errors.New("cannot read properties data")
+	ErrCannotReadPropertiesBytes = errors.New("cannot read properties bytes")
+)
+
+var (
+	errInvalidUnicodeEscape = errors.New("invalid unicode escape")
+	errConflictingKeys      = errors.New("conflicting keys")
+	errEmptyKeySegment      = errors.New("empty key segment")
+)
+
+const (
+	keySeparator   = "."
+	parentValueKey = "_value"
+)
+
+// Container is a struct that holds the loaded values.
+type Container struct {
+	values []any
+	errors []error
+}
+
+// Get returns the loaded properties values.
+func (c *Container) Get() []any {
+	return c.values
+}
+
+// Errors returns the errors that occurred during the loading process.
+func (c *Container) Errors() []error {
+	return c.errors
+}
+
+// Load creates an empty container, into which the properties values can be loaded.
+func Load() *Container {
+	container := new(Container)
+
+	return container
+}
+
+// FromFile loads a properties file from the given path.
+func (c *Container) FromFile(path string) *Container {
+	bytes, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		c.errors = append(c.errors, fmt.Errorf("%w: %w", ErrCannotOpenPropertiesFile, err))
+
+		return c
+	}
+
+	c.readFromBytes(bytes)
+
+	return c
+}
+
+// FromString loads a properties file from the given string.
+func (c *Container) FromString(input string) *Container {
+	c.readFromBytes([]byte(input))
+
+	return c
+}
+
+// FromReader loads a properties file from a reader stream.
+func (c *Container) FromReader(reader io.Reader) *Container {
+	if reader == nil {
+		c.errors = append(c.errors, ErrCannotReadPropertiesData)
+
+		return c
+	}
+
+	buffer := new(bytes.Buffer)
+
+	if _, err := buffer.ReadFrom(reader); err != nil {
+		c.errors = append(c.errors, fmt.Errorf("%w: %w", ErrCannotReadPropertiesData, err))
+
+		return c
+	}
+
+	c.readFromBytes(buffer.Bytes())
+
+	return c
+}
+
+// FromBytes loads a properties file from the given bytes.
+func (c *Container) FromBytes(input []byte) *Container {
+	c.readFromBytes(input)
+
+	return c
+}
+
+func (c *Container) readFromBytes(input []byte) {
+	value, err := parse(string(input))
+	if err != nil {
+		c.errors = append(c.errors, fmt.Errorf("%w: %w", ErrCannotReadPropertiesBytes, err))
+
+		return
+	}
+
+	c.values = append(c.values, value)
+}
+
+// parse parses the properties into a map, in which the keys are used as paths, so that dotted keys such as db.pool.size
+// are expanded into nested maps, and indexed keys such as servers[0].port into slices.
+func parse(input string) (any, error) {
+	values := confiq.New()
+
+	for _, line := range logicalLines(input) {
+		key, value, err := parseProperty(line.text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", line.number, err)
+		}
+
+		if err := setValue(values, key, value); err != nil {
+			return nil, fmt.Errorf("line %d: %w", line.number, err)
+		}
+	}
+
+	result, err := values.Get("")
+	if err != nil || result == nil {
+		result = map[string]any{}
+	}
+
+	return result, nil
+}
+
+type logicalLine struct {
+	number int
+	text   string
+}
+
+// logicalLines joins the lines which end with an odd number of backslashes with the following line,
+// skipping the leading whitespace of the continuation lines, and omits the blank lines and comments.
+func logicalLines(input string) []logicalLine {
+	var (
+		lines        []logicalLine
+		currentLine  logicalLine
+		continuation = false
+	)
+
+	input = strings.ReplaceAll(strings.ReplaceAll(input, "\r\n", "\n"), "\r", "\n")
+
+	for lineIndex, line := range strings.Split(input, "\n") {
+		line = strings.TrimLeft(line, " \t\f")
+
+		if !continuation {
+			if line == "" || line[0] == '#' || line[0] == '!' {
+				continue
+			}
+
+			currentLine.number = lineIndex + 1
+		}
+
+		continuation = endsWithContinuation(line)
+		if continuation {
+			line = line[:len(line)-1]
+		}
+
+		currentLine.text += line
+
+		if !continuation {
+			lines = append(lines, currentLine)
+			currentLine.text = ""
+		}
+	}
+
+	if currentLine.text != "" {
+		lines = append(lines, currentLine)
+	}
+
+	return lines
+}
+
+func endsWithContinuation(line string) bool {
+	backslashCount := len(line) - len(strings.TrimRight(line, "\\"))
+
+	return backslashCount%2 == 1
+}
+
+// parseProperty splits the line into a key and a value at the first unescaped =, : or whitespace, and unescapes both.
+func parseProperty(line string) (string, string, error) {
+	separatorIndex := len(line)
+
+	for i := 0; i < len(line); i++ {
+		if line[i] == '\\' {
+			i++
+
+			continue
+		}
+
+		if strings.IndexByte("=: \t\f", line[i]) >= 0 {
+			separatorIndex = i
+
+			break
+		}
+	}
+
+	key, err := unescape(line[:separatorIndex])
+	if err != nil {
+		return "", "", err
+	}
+
+	// the separator may be surrounded by whitespace, and = or : may follow whitespace
+	rest := strings.TrimLeft(line[separatorIndex:], " \t\f")
+	if rest != "" && (rest[0] == '=' || rest[0] == ':') {
+		rest = strings.TrimLeft(rest[1:], " \t\f")
+	}
+
+	value, err := unescape(rest)
+	if err != nil {
+		return "", "", err
+	}
+
+	return key, value, nil
+}
+
+func unescape(value string) (string, error) {
+	if !strings.Contains(value, "\\") {
+		return value, nil
+	}
+
+	var unescaped strings.Builder
+
+	for i := 0; i < len(value); i++ {
+		if value[i] != '\\' || i+1 == len(value) {
+			unescaped.WriteByte(value[i])
+
+			continue
+		}
+
+		i++
+
+		switch value[i] {
+		case 't':
+			unescaped.WriteByte('\t')
+		case 'n':
+			unescaped.WriteByte('\n')
+		case 'r':
+			unescaped.WriteByte('\r')
+		case 'f':
+			unescaped.WriteByte('\f')
+		case 'u':
+			if i+4 >= len(value) {
+				return "", fmt.Errorf("%w: %s", errInvalidUnicodeEscape, value[i-1:])
+			}
+
+			codePoint, err := strconv.ParseUint(value[i+1:i+5], 16, 16)
+			if err != nil {
+				return "", fmt.Errorf("%w: %s", errInvalidUnicodeEscape, value[i-1:i+5])
+			}
+
+			i += 4
+
+			// characters outside of the basic multilingual plane are escaped as surrogate pairs
+			if utf16.IsSurrogate(rune(codePoint)) && strings.HasPrefix(value[i+1:], "\\u") && len(value) >= i+7 {
+				if lowSurrogate, err := strconv.ParseUint(value[i+3:i+7], 16, 16); err == nil {
+					if decodedRune := utf16.DecodeRune(rune(codePoint), rune(lowSurrogate)); decodedRune != unicode.ReplacementChar {
+						unescaped.WriteRune(decodedRune)
+
+						i += 6
+
+						continue
+					}
+				}
+			}
+
+			unescaped.WriteRune(rune(codePoint))
+		default:
+			unescaped.WriteByte(value[i])
+		}
+	}
+
+	return unescaped.String(), nil
+}
+
+// setValue sets the value at the path of the key. The values of the keys which are the parents of other keys,
+// such as log4j.logger of log4j.logger.foo, are kept under the reserved parentValueKey in the maps of their children.
+func setValue(values *confiq.ConfigSet, key, value string) error {
+	segments := strings.Split(key, keySeparator)
+	if slices.Contains(segments, "") {
+		return fmt.Errorf("%w: %s", errEmptyKeySegment, key)
+	}
+
+	// the values set at the parents of the key are moved into the maps of their children
+	for i := 1; i < len(segments); i++ {
+		parentKey := strings.Join(segments[:i], keySeparator)
+
+		parentValue, err := values.Get(parentKey)
+		if err != nil {
+			continue
+		}
+
+		if parentValueString, ok := parentValue.(string); ok {
+			if err := values.Set(parentKey, map[string]any{parentValueKey: parentValueString}); err != nil {
+				return fmt.Errorf("%w: %s: %w", errConflictingKeys, key, err)
+			}
+		}
+	}
+
+	if existingValue, err := values.Get(key); err == nil {
+		switch existingValue.(type) {
+		case map[string]any:
+			key += keySeparator + parentValueKey
+		case []any:
+			return fmt.Errorf("%w: %s", errConflictingKeys, key)
+		}
+	}
+
+	if err := values.Set(key, value); err != nil {
+		return fmt.Errorf("%w: %s: %w", errConflictingKeys, key, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,244 @@
+package confiqproperties_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	confiqproperties "github.com/greencoda/confiq/loaders/properties"
+	"github.com/stretchr/testify/suite"
+)
+
+var errFailedToRead = errors.New("failed to read")
+
+type brokenReader struct{}
+
+func (bR brokenReader) Read(_ []byte) (int, error) {
+	return 0, errFailedToRead
+}
+
+type PropertiesTestSuite struct {
+	suite.Suite
+
+	c *confiqproperties.Container
+}
+
+func Test_PropertiesTestSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(PropertiesTestSuite))
+}
+
+func (s *PropertiesTestSuite) SetupTest() {
+	s.c = confiqproperties.Load()
+	s.Require().NotNil(s.c)
+}
+
+func (s *PropertiesTestSuite) Test_Get() {
+	s.c.FromBytes([]byte("test_string = test"))
+
+	s.Require().Len(s.c.Get(), 1)
+	s.Require().Empty(s.c.Errors())
+
+	valueMap, ok := s.c.Get()[0].(map[string]any)
+	s.Require().True(ok)
+
+	s.Contains(valueMap, "test_string")
+	s.Equal("test", valueMap["test_string"])
+}
+
+func (s *PropertiesTestSuite) Test_FromFile() {
+	s.c.FromFile("testdata/valid.properties")
+
+	s.Len(s.c.Get(), 1)
+	s.Empty(s.c.Errors())
+}
+
+func (s *PropertiesTestSuite) Test_FromFile_InvalidPath() {
+	s.c.FromFile("testdata/nonexistent.properties")
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqproperties.ErrCannotOpenPropertiesFile)
+}
+
+func (s *PropertiesTestSuite) Test_FromFile_Invalid() {
+	s.c.FromFile("testdata/invalid.properties")
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqproperties.ErrCannotReadPropertiesBytes)
+}
+
+func (s *PropertiesTestSuite) Test_FromString() {
+	s.c.FromString("test_string = test")
+
+	s.Len(s.c.Get(), 1)
+	s.Empty(s.c.Errors())
+}
+
+func (s *PropertiesTestSuite) Test_FromString_Invalid() {
+	s.c.FromString("test = \\u12")
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqproperties.ErrCannotReadPropertiesBytes)
+}
+
+func (s *PropertiesTestSuite) Test_FromReader() {
+	s.c.FromReader(strings.NewReader("test_string = test"))
+
+	s.Len(s.c.Get(), 1)
+	s.Empty(s.c.Errors())
+}
+
+func (s *PropertiesTestSuite) Test_FromReader_Invalid() {
+	s.c.FromReader(strings.NewReader("test = \\u12"))
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqproperties.ErrCannotReadPropertiesBytes)
+}
+
+func (s *PropertiesTestSuite) Test_FromReader_Nil() {
+	s.c.FromReader(nil)
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqproperties.ErrCannotReadPropertiesData)
+}
+
+func (s *PropertiesTestSuite) Test_FromReader_BrokenReader() {
+	s.c.FromReader(brokenReader{})
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqproperties.ErrCannotReadPropertiesData)
+}
+
+func (s *PropertiesTestSuite) Test_FromBytes() {
+	s.c.FromBytes([]byte("test_string = test"))
+
+	s.Len(s.c.Get(), 1)
+	s.Empty(s.c.Errors())
+}
+
+func (s *PropertiesTestSuite) Test_FromBytes_Invalid() {
+	s.c.FromBytes([]byte("test = \\u12"))
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqproperties.ErrCannotReadPropertiesBytes)
+}
+
+func (s *PropertiesTestSuite) Test_Get_NestedKeys() {
+	s.c.FromString(`
+# database settings
+db.host = localhost
+db.pool.size = 10
+! the server settings
+server.port: 8080
+server.name   primary
+`)
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	s.Equal(map[string]any{
+		"db": map[string]any{
+			"host": "localhost",
+			"pool": map[string]any{"size": "10"},
+		},
+		"server": map[string]any{
+			"port": "8080",
+			"name": "primary",
+		},
+	}, s.c.Get()[0])
+}
+
+func (s *PropertiesTestSuite) Test_Get_IndexedKeys() {
+	s.c.FromString(`
+servers[0].host = localhost
+servers[0].port = 80
+servers[1].port = 8080
+tags[0] = primary
+`)
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	s.Equal(map[string]any{
+		"servers": []any{
+			map[string]any{"host": "localhost", "port": "80"},
+			map[string]any{"port": "8080"},
+		},
+		"tags": []any{"primary"},
+	}, s.c.Get()[0])
+}
+
+func (s *PropertiesTestSuite) Test_Get_ParentKeys() {
+	for _, input := range []string{
+		"log4j.logger = INFO\nlog4j.logger.foo = DEBUG\nlog4j.logger.foo.bar = TRACE",
+		"log4j.logger.foo.bar = TRACE\nlog4j.logger.foo = DEBUG\nlog4j.logger = INFO",
+	} {
+		container := confiqproperties.Load().FromString(input)
+
+		s.Require().Empty(container.Errors(), input)
+		s.Require().Len(container.Get(), 1, input)
+
+		s.Equal(map[string]any{
+			"log4j": map[string]any{
+				"logger": map[string]any{
+					"_value": "INFO",
+					"foo": map[string]any{
+						"_value": "DEBUG",
+						"bar":    "TRACE",
+					},
+				},
+			},
+		}, container.Get()[0], input)
+	}
+}
+
+func (s *PropertiesTestSuite) Test_Get_Escapes() {
+	s.c.FromString(`
+escaped\ key = value with \= and \: signs
+tabs = a\tb
+unicode = caf\u00e9 \uD83D\uDE00
+empty =
+trailing_backslash = C:\\path\\
+continued = first, \
+            second, \
+            third
+windows = line\
+    continued
+`)
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	s.Equal(map[string]any{
+		"escaped key":        "value with = and : signs",
+		"tabs":               "a\tb",
+		"unicode":            "café 😀",
+		"empty":              "",
+		"trailing_backslash": `C:\path\`,
+		"continued":          "first, second, third",
+		"windows":            "linecontinued",
+	}, s.c.Get()[0])
+}
+
+func (s *PropertiesTestSuite) Test_Get_Invalid() {
+	for _, input := range []string{
+		`unicode = \uZZZZ`,
+		"db..host = localhost",
+		"servers[0].port = 80\nservers = primary",
+		"servers[999999999999999999] = 80",
+	} {
+		container := confiqproperties.Load().FromString(input)
+
+		s.Empty(container.Get(), input)
+		s.Require().Len(container.Errors(), 1, input)
+		s.ErrorIs(container.Errors()[0], confiqproperties.ErrCannotReadPropertiesBytes, input)
+	}
+}
@@ -0,0 +1,227 @@
+// Package confiqxml allows confiq values to be loaded from XML format.
+package confiqxml
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	defaultAttributePrefix = "@"
+	defaultTextKey         = "#text"
+)
+
+var (
+	ErrCannotOpenXMLFile  = errors.New("cannot open XML file")
+	ErrCannotReadXMLData  = errors.New("cannot read XML data")
+	ErrCannotReadXMLBytes = errors.New("cannot read XML bytes")
+)
+
+var (
+	errMissingRootElement   = errors.New("missing root element")
+	errMultipleRootElements = errors.New("multiple root elements")
+)
+
+// Container is a struct that holds the loaded values.
+type Container struct {
+	values          []any
+	errors          []error
+	attributePrefix string
+	textKey         string
+}
+
+// Get returns the loaded XML values.
+func (c *Container) Get() []any {
+	return c.values
+}
+
+// Errors returns the errors that occurred during the loading process.
+func (c *Container) Errors() []error {
+	return c.errors
+}
+
+// Load creates an empty container, into which the XML values can be loaded.
+func Load() *Container {
+	container := &Container{
+		values:          nil,
+		errors:          nil,
+		attributePrefix: defaultAttributePrefix,
+		textKey:         defaultTextKey,
+	}
+
+	return container
+}
+
+// WithAttributePrefix sets the prefix of the keys under which the attributes of the elements are loaded, which is "@" by default.
+func (c *Container) WithAttributePrefix(prefix string) *Container {
+	c.attributePrefix = prefix
+
+	return c
+}
+
+// WithTextKey sets the key under which the text content of the elements with attributes or child elements is loaded, which is "#text" by default.
+func (c *Container) WithTextKey(key string) *Container {
+	c.textKey = key
+
+	return c
+}
+
+// FromFile loads a XML file from the given path.
+func (c *Container) FromFile(path string) *Container {
+	bytes, err := os.ReadFile(filepath.Clean(path))
+	if err != nil {
+		c.errors = append(c.errors, fmt.Errorf("%w: %w", ErrCannotOpenXMLFile, err))
+
+		return c
+	}
+
+	c.readFromBytes(bytes)
+
+	return c
+}
+
+// FromString loads a XML file from the given string.
+func (c *Container) FromString(input string) *Container {
+	c.readFromBytes([]byte(input))
+
+	return c
+}
+
+// FromReader loads a XML file from a reader stream.
+func (c *Container) FromReader(reader io.Reader) *Container {
+	if reader == nil {
+		c.errors = append(c.errors, ErrCannotReadXMLData)
+
+		return c
+	}
+
+	buffer := new(bytes.Buffer)
+
+	if _, err := buffer.ReadFrom(reader); err != nil {
+		c.errors = append(c.errors, fmt.Errorf("%w: %w", ErrCannotReadXMLData, err))
+
+		return c
+	}
+
+	c.readFromBytes(buffer.Bytes())
+
+	return c
+}
+
+// FromBytes loads a XML file from the given bytes.
+func (c *Container) FromBytes(input []byte) *Container {
+	c.readFromBytes(input)
+
+	return c
+}
+
+func (c *Container) readFromBytes(input []byte) {
+	value, err := c.decodeDocument(xml.NewDecoder(bytes.NewReader(input)))
+	if err != nil {
+		c.errors = append(c.errors, fmt.Errorf("%w: %w", ErrCannotReadXMLBytes, err))
+
+		return
+	}
+
+	c.values = append(c.values, value)
+}
+
+// decodeDocument decodes the root element of the document into a map, under the name of the root element.
+func (c *Container) decodeDocument(decoder *xml.Decoder) (map[string]any, error) {
+	var result map[string]any
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		if startElement, ok := token.(xml.StartElement); ok {
+			if result != nil {
+				return nil, fmt.Errorf("%w: %s", errMultipleRootElements, startElement.Name.Local)
+			}
+
+			rootValue, err := c.decodeElement(decoder, startElement)
+			if err != nil {
+				return nil, err
+			}
+
+			result = map[string]any{startElement.Name.Local: rootValue}
+		}
+	}
+
+	if result == nil {
+		return nil, errMissingRootElement
+	}
+
+	return result, nil
+}
+
+// decodeElement decodes an element into a map of its attributes, child elements and text content,
+// in which repeated child elements are collected into slices.
+// Elements without attributes or child elements are decoded into their text content.
+func (c *Container) decodeElement(decoder *xml.Decoder, startElement xml.StartElement) (any, error) {
+	var (
+		result = make(map[string]any)
+		text   strings.Builder
+	)
+
+	for _, attribute := range startElement.Attr {
+		// namespace declarations are not part of the config values
+		if attribute.Name.Space == "xmlns" || attribute.Name.Local == "xmlns" {
+			continue
+		}
+
+		result[c.attributePrefix+attribute.Name.Local] = attribute.Value
+	}
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		switch typedToken := token.(type) {
+		case xml.StartElement:
+			childValue, err := c.decodeElement(decoder, typedToken)
+			if err != nil {
+				return nil, err
+			}
+
+			addChild(result, typedToken.Name.Local, childValue)
+		case xml.CharData:
+			text.Write(typedToken)
+		case xml.EndElement:
+			textContent := strings.TrimSpace(text.String())
+
+			if len(result) == 0 {
+				return textContent, nil
+			}
+
+			if textContent != "" {
+				result[c.textKey] = textContent
+			}
+
+			return result, nil
+		}
+	}
+}
+
+func addChild(result map[string]any, name string, value any) {
+	switch existingValue := result[name].(type) {
+	case nil:
+		result[name] = value
+	case []any:
+		result[name] = append(existingValue, value)
+	default:
+		result[name] = []any{existingValue, value}
+	}
+}
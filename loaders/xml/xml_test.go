@@ -0,0 +1,191 @@
+package confiqxml_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	confiqxml "github.com/greencoda/confiq/loaders/xml"
+	"github.com/stretchr/testify/suite"
+)
+
+var errFailedToRead = errors.New("failed to read")
+
+type brokenReader struct{}
+
+func (bR brokenReader) Read(_ []byte) (int, error) {
+	return 0, errFailedToRead
+}
+
+type XMLTestSuite struct {
+	suite.Suite
+
+	c *confiqxml.Container
+}
+
+func Test_XMLTestSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(XMLTestSuite))
+}
+
+func (s *XMLTestSuite) SetupTest() {
+	s.c = confiqxml.Load()
+	s.Require().NotNil(s.c)
+}
+
+func (s *XMLTestSuite) Test_Get() {
+	s.c.FromBytes([]byte("<config><test_string>test</test_string></config>"))
+
+	s.Require().Len(s.c.Get(), 1)
+	s.Require().Empty(s.c.Errors())
+
+	valueMap, ok := s.c.Get()[0].(map[string]any)
+	s.Require().True(ok)
+
+	s.Equal(map[string]any{"config": map[string]any{"test_string": "test"}}, valueMap)
+}
+
+func (s *XMLTestSuite) Test_FromFile() {
+	s.c.FromFile("testdata/valid.xml")
+
+	s.Len(s.c.Get(), 1)
+	s.Empty(s.c.Errors())
+}
+
+func (s *XMLTestSuite) Test_FromFile_InvalidPath() {
+	s.c.FromFile("testdata/nonexistent.xml")
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqxml.ErrCannotOpenXMLFile)
+}
+
+func (s *XMLTestSuite) Test_FromFile_Invalid() {
+	s.c.FromFile("testdata/invalid.xml")
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqxml.ErrCannotReadXMLBytes)
+}
+
+func (s *XMLTestSuite) Test_FromString() {
+	s.c.FromString("<config><test_string>test</test_string></config>")
+
+	s.Len(s.c.Get(), 1)
+	s.Empty(s.c.Errors())
+}
+
+func (s *XMLTestSuite) Test_FromString_Invalid() {
+	s.c.FromString("<config>")
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqxml.ErrCannotReadXMLBytes)
+}
+
+func (s *XMLTestSuite) Test_FromReader() {
+	s.c.FromReader(strings.NewReader("<config><test_string>test</test_string></config>"))
+
+	s.Len(s.c.Get(), 1)
+	s.Empty(s.c.Errors())
+}
+
+func (s *XMLTestSuite) Test_FromReader_Invalid() {
+	s.c.FromReader(strings.NewReader("<config>"))
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqxml.ErrCannotReadXMLBytes)
+}
+
+func (s *XMLTestSuite) Test_FromReader_Nil() {
+	s.c.FromReader(nil)
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqxml.ErrCannotReadXMLData)
+}
+
+func (s *XMLTestSuite) Test_FromReader_BrokenReader() {
+	s.c.FromReader(brokenReader{})
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqxml.ErrCannotReadXMLData)
+}
+
+func (s *XMLTestSuite) Test_FromBytes() {
+	s.c.FromBytes([]byte("<config><test_string>test</test_string></config>"))
+
+	s.Len(s.c.Get(), 1)
+	s.Empty(s.c.Errors())
+}
+
+func (s *XMLTestSuite) Test_FromBytes_Invalid() {
+	s.c.FromBytes([]byte("<config>"))
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqxml.ErrCannotReadXMLBytes)
+}
+
+func (s *XMLTestSuite) Test_Get_Elements() {
+	s.c.FromString(`<?xml version="1.0" encoding="UTF-8"?>
+<!-- vendor settings -->
+<settings xmlns="http://example.com/settings" version="2">
+	<name>service</name>
+	<server host="localhost" port="8080"/>
+	<server host="replica" port="8081">secondary</server>
+	<description><![CDATA[a <raw> description]]></description>
+	<empty></empty>
+</settings>
+`)
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	s.Equal(map[string]any{
+		"settings": map[string]any{
+			"@version": "2",
+			"name":     "service",
+			"server": []any{
+				map[string]any{"@host": "localhost", "@port": "8080"},
+				map[string]any{"@host": "replica", "@port": "8081", "#text": "secondary"},
+			},
+			"description": "a <raw> description",
+			"empty":       "",
+		},
+	}, s.c.Get()[0])
+}
+
+func (s *XMLTestSuite) Test_Get_WithAttributePrefixAndTextKey() {
+	s.c.
+		WithAttributePrefix("attr_").
+		WithTextKey("value").
+		FromString(`<config><timeout unit="s">30</timeout></config>`)
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	s.Equal(map[string]any{
+		"config": map[string]any{
+			"timeout": map[string]any{"attr_unit": "s", "value": "30"},
+		},
+	}, s.c.Get()[0])
+}
+
+func (s *XMLTestSuite) Test_Get_Invalid() {
+	for _, input := range []string{
+		"",
+		"<!-- only a comment -->",
+		"<config></settings>",
+		"<config/><settings/>",
+	} {
+		container := confiqxml.Load().FromString(input)
+
+		s.Empty(container.Get(), input)
+		s.Require().Len(container.Errors(), 1, input)
+		s.ErrorIs(container.Errors()[0], confiqxml.ErrCannotReadXMLBytes, input)
+	}
+}
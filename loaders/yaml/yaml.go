@@ -8,6 +8,8 @@ import (
 	"io"
 	"os"
 	"path/filepath"
+	"slices"
+	"strings"
 
 	"github.com/goccy/go-yaml"
 )
@@ -18,10 +20,23 @@ var (
 	ErrCannotReadYAMLBytes = errors.New("cannot read YAML bytes")
 )
 
+const (
+	documentStartMarker  = "---"
+	directivePrefix      = "%"
+	selectorKeySeparator = "."
+)
+
 // Container is a struct that holds the loaded values.
 type Container struct {
-	values []any
-	errors []error
+	values            []any
+	errors            []error
+	documentIndices   []int
+	documentSelectors []documentSelector
+}
+
+type documentSelector struct {
+	key   string
+	value string
 }
 
 // Get returns the loaded YAML values.
@@ -36,11 +51,36 @@ func (c *Container) Errors() []error {
 
 // Load creates an empty container, into which the YAML values can be loaded.
 func Load() *Container {
-	container := new(Container)
+	container := &Container{
+		values:            nil,
+		errors:            nil,
+		documentIndices:   nil,
+		documentSelectors: nil,
+	}
 
 	return container
 }
 
+// WithDocuments selects the documents of multi-document YAML streams to be loaded by their zero-based indices.
+// By default all documents are loaded.
+func (c *Container) WithDocuments(indices ...int) *Container {
+	c.documentIndices = append(c.documentIndices, indices...)
+
+	return c
+}
+
+// WithDocumentSelector selects the documents of multi-document YAML streams to be loaded by the value at the given key, e.g. profile: prod.
+// The key may be a dotted path of nested keys, and the value is compared to the document's value in its string form.
+// If multiple selectors are set, only the documents matching all of them are loaded.
+func (c *Container) WithDocumentSelector(key, value string) *Container {
+	c.documentSelectors = append(c.documentSelectors, documentSelector{
+		key:   key,
+		value: value,
+	})
+
+	return c
+}
+
 // FromFile loads a YAML file from the given path.
 func (c *Container) FromFile(path string) *Container {
 	bytes, err := os.ReadFile(filepath.Clean(path))
@@ -90,14 +130,106 @@ func (c *Container) FromBytes(input []byte) *Container {
 	return c
 }
 
+// readFromBytes loads each selected document of the YAML stream as a separate value, so that they are merged in order.
+// None of the documents are loaded if any of them is invalid.
 func (c *Container) readFromBytes(input []byte) {
-	var value any
+	var (
+		values []any
+		index  = 0
+	)
+
+	for documentNumber, document := range splitDocuments(input) {
+		var value any
+
+		if err := yaml.Unmarshal(document, &value); err != nil {
+			c.errors = append(c.errors, fmt.Errorf("%w: document %d: %w", ErrCannotReadYAMLBytes, index, err))
+
+			return
+		}
+
+		// the content before the first document start marker is only a document if it isn't empty
+		if documentNumber == 0 && value == nil {
+			continue
+		}
+
+		// empty documents have no values to be loaded
+		if value != nil && c.isSelected(index, value) {
+			values = append(values, value)
+		}
+
+		index++
+	}
+
+	c.values = append(c.values, values...)
+}
+
+// splitDocuments splits the YAML stream into its documents at the document start markers,
+// keeping the directives together with the documents that follow them.
+// The documents are split before decoding them, as the stream decoder of the YAML package stops at the first empty document.
+func splitDocuments(input []byte) [][]byte {
+	var (
+		documents          [][]byte
+		currentDocument    []byte
+		afterDirectiveLine = false
+	)
+
+	for _, line := range bytes.SplitAfter(input, []byte("\n")) {
+		switch {
+		case bytes.HasPrefix(line, []byte(directivePrefix)):
+			if !afterDirectiveLine {
+				documents = append(documents, currentDocument)
+				currentDocument = nil
+			}
+
+			afterDirectiveLine = true
+		case isDocumentStartMarker(line):
+			if !afterDirectiveLine {
+				documents = append(documents, currentDocument)
+				currentDocument = nil
+			}
+
+			afterDirectiveLine = false
+		}
+
+		currentDocument = append(currentDocument, line...)
+	}
+
+	return append(documents, currentDocument)
+}
+
+func isDocumentStartMarker(line []byte) bool {
+	rest, found := bytes.CutPrefix(bytes.TrimRight(line, "\r\n"), []byte(documentStartMarker))
+
+	return found && (len(rest) == 0 || rest[0] == ' ' || rest[0] == '\t')
+}
+
+func (c *Container) isSelected(index int, document any) bool {
+	if len(c.documentIndices) > 0 && !slices.Contains(c.documentIndices, index) {
+		return false
+	}
+
+	for _, selector := range c.documentSelectors {
+		if !selector.matches(document) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (dS documentSelector) matches(document any) bool {
+	value := document
 
-	if err := yaml.Unmarshal(input, &value); err != nil {
-		c.errors = append(c.errors, fmt.Errorf("%w: %w", ErrCannotReadYAMLBytes, err))
+	for _, key := range strings.Split(dS.key, selectorKeySeparator) {
+		valueMap, ok := value.(map[string]any)
+		if !ok {
+			return false
+		}
 
-		return
+		if value, ok = valueMap[key]; !ok {
+			return false
+		}
 	}
 
-	c.values = append(c.values, value)
+	return fmt.Sprint(value) == dS.value
 }
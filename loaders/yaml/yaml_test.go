@@ -130,3 +130,87 @@ func (s *YAMLTestSuite) Test_FromBytes_Invalid() {
 	s.Len(s.c.Errors(), 1)
 	s.ErrorIs(s.c.Errors()[0], confiqyaml.ErrCannotReadYAMLBytes)
 }
+
+func (s *YAMLTestSuite) Test_FromFile_MultipleDocuments() {
+	s.c.FromFile("testdata/multi.yaml")
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 3)
+
+	s.Equal(map[string]any{"name": "base", "db": map[string]any{"host": "localhost"}}, s.c.Get()[0])
+	s.Equal(map[string]any{"profile": "dev", "db": map[string]any{"host": "dev.example.com"}}, s.c.Get()[1])
+}
+
+func (s *YAMLTestSuite) Test_FromString_EmptyDocuments() {
+	s.c.FromString("---\n---\nname: test\n---\n")
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	s.Equal(map[string]any{"name": "test"}, s.c.Get()[0])
+}
+
+func (s *YAMLTestSuite) Test_FromString_InvalidDocument() {
+	s.c.FromString("name: test\n---\n{\n")
+
+	s.Empty(s.c.Get())
+	s.Len(s.c.Errors(), 1)
+	s.ErrorIs(s.c.Errors()[0], confiqyaml.ErrCannotReadYAMLBytes)
+}
+
+func (s *YAMLTestSuite) Test_WithDocuments() {
+	s.c.WithDocuments(0, 2).FromFile("testdata/multi.yaml")
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 2)
+
+	s.Equal("base", s.c.Get()[0].(map[string]any)["name"])
+	s.Equal("prod", s.c.Get()[1].(map[string]any)["profile"])
+}
+
+func (s *YAMLTestSuite) Test_WithDocumentSelector() {
+	s.c.WithDocumentSelector("profile", "prod").FromFile("testdata/multi.yaml")
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	s.Equal("prod", s.c.Get()[0].(map[string]any)["profile"])
+}
+
+func (s *YAMLTestSuite) Test_WithDocumentSelector_NestedKey() {
+	s.c.WithDocumentSelector("db.replica.enabled", "true").FromFile("testdata/multi.yaml")
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	s.Equal("prod", s.c.Get()[0].(map[string]any)["profile"])
+}
+
+func (s *YAMLTestSuite) Test_WithDocumentSelector_NoMatch() {
+	s.c.
+		WithDocuments(1).
+		WithDocumentSelector("profile", "prod").
+		FromFile("testdata/multi.yaml")
+
+	s.Empty(s.c.Errors())
+	s.Empty(s.c.Get())
+}
+
+func (s *YAMLTestSuite) Test_FromString_Directives() {
+	s.c.FromString("%YAML 1.2\n---\nname: first\n...\n%YAML 1.2\n--- # second\nname: second\n")
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 2)
+
+	s.Equal(map[string]any{"name": "first"}, s.c.Get()[0])
+	s.Equal(map[string]any{"name": "second"}, s.c.Get()[1])
+}
+
+func (s *YAMLTestSuite) Test_WithDocuments_EmptyDocuments() {
+	s.c.WithDocuments(2).FromString("name: first\n---\n---\nname: third\n")
+
+	s.Require().Empty(s.c.Errors())
+	s.Require().Len(s.c.Get(), 1)
+
+	s.Equal(map[string]any{"name": "third"}, s.c.Get()[0])
+}
@@ -0,0 +1,98 @@
+package confiq
+
+import (
+	"strings"
+	"unicode"
+)
+
+// NamingStrategy derives the config path of a struct field from its name,
+// for fields which don't have a path defined in their struct tag.
+type NamingStrategy func(fieldName string) string
+
+// CamelCase converts the field name to camelCase, e.g. MaxConnections to maxConnections.
+func CamelCase(fieldName string) string {
+	words := splitFieldName(fieldName)
+
+	for i, word := range words {
+		if i == 0 {
+			words[i] = strings.ToLower(word)
+		} else {
+			words[i] = capitalize(word)
+		}
+	}
+
+	return strings.Join(words, "")
+}
+
+// SnakeCase converts the field name to snake_case, e.g. MaxConnections to max_connections.
+func SnakeCase(fieldName string) string {
+	return strings.ToLower(strings.Join(splitFieldName(fieldName), "_"))
+}
+
+// KebabCase converts the field name to kebab-case, e.g. MaxConnections to max-connections.
+func KebabCase(fieldName string) string {
+	return strings.ToLower(strings.Join(splitFieldName(fieldName), "-"))
+}
+
+// ScreamingSnakeCase converts the field name to SCREAMING_SNAKE_CASE, e.g. MaxConnections to MAX_CONNECTIONS.
+func ScreamingSnakeCase(fieldName string) string {
+	return strings.ToUpper(strings.Join(splitFieldName(fieldName), "_"))
+}
+
+// splitFieldName splits a field name into words at underscores and case changes,
+// keeping initialisms such as the HTTP in HTTPServer together.
+func splitFieldName(fieldName string) []string {
+	var (
+		words []string
+		runes = []rune(fieldName)
+		start = 0
+	)
+
+	for i := range runes {
+		if runes[i] == '_' {
+			if i > start {
+				words = append(words, string(runes[start:i]))
+			}
+
+			start = i + 1
+
+			continue
+		}
+
+		if i > start && isWordBoundary(runes, i) {
+			words = append(words, string(runes[start:i]))
+			start = i
+		}
+	}
+
+	if start < len(runes) {
+		words = append(words, string(runes[start:]))
+	}
+
+	return words
+}
+
+func isWordBoundary(runes []rune, i int) bool {
+	previous, current := runes[i-1], runes[i]
+
+	if !unicode.IsUpper(current) {
+		return false
+	}
+
+	if unicode.IsLower(previous) || unicode.IsDigit(previous) {
+		return true
+	}
+
+	return unicode.IsUpper(previous) && i+1 < len(runes) && unicode.IsLower(runes[i+1])
+}
+
+func capitalize(word string) string {
+	runes := []rune(strings.ToLower(word))
+	if len(runes) == 0 {
+		return ""
+	}
+
+	runes[0] = unicode.ToUpper(runes[0])
+
+	return string(runes)
+}
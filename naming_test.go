@@ -0,0 +1,148 @@
+package confiq_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/greencoda/confiq"
+	"github.com/greencoda/confiq/mocks"
+	"github.com/stretchr/testify/suite"
+)
+
+type NamingTestSuite struct {
+	suite.Suite
+
+	valueContainer *mocks.IValueContainer
+}
+
+func Test_NamingTestSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(NamingTestSuite))
+}
+
+func (s *NamingTestSuite) SetupTest() {
+	s.valueContainer = mocks.NewIValueContainer(s.T())
+}
+
+func (s *NamingTestSuite) Test_CamelCase() {
+	s.Equal("maxConnections", confiq.CamelCase("MaxConnections"))
+	s.Equal("httpServerUrl", confiq.CamelCase("HTTPServerURL"))
+	s.Equal("userId", confiq.CamelCase("UserID"))
+	s.Equal("port2", confiq.CamelCase("Port2"))
+}
+
+func (s *NamingTestSuite) Test_SnakeCase() {
+	s.Equal("max_connections", confiq.SnakeCase("MaxConnections"))
+	s.Equal("http_server_url", confiq.SnakeCase("HTTPServerURL"))
+	s.Equal("api_key", confiq.SnakeCase("API_Key"))
+}
+
+func (s *NamingTestSuite) Test_KebabCase() {
+	s.Equal("max-connections", confiq.KebabCase("MaxConnections"))
+	s.Equal("read-only-mode", confiq.KebabCase("ReadOnlyMode"))
+}
+
+func (s *NamingTestSuite) Test_ScreamingSnakeCase() {
+	s.Equal("MAX_CONNECTIONS", confiq.ScreamingSnakeCase("MaxConnections"))
+	s.Equal("DB_HOST", confiq.ScreamingSnakeCase("DBHost"))
+}
+
+func (s *NamingTestSuite) Test_Decode_WithNamingStrategy() {
+	type settingsStruct struct {
+		ReadOnlyMode   bool
+		MaxConnections int
+	}
+
+	type targetStruct struct {
+		ServerHost string
+		APIKey     string `cfg:"apiKeys[1]"`
+		Settings   settingsStruct
+		Ignored    string `cfg:"-"`
+	}
+
+	configSet := confiq.New(
+		confiq.WithNamingStrategy(confiq.CamelCase),
+	)
+
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"serverHost": "localhost",
+		"apiKeys":    []any{"testKey1", "testKey2"},
+		"settings": map[string]any{
+			"readOnlyMode":   true,
+			"maxConnections": 10,
+		},
+		"ignored": "test",
+	}})
+
+	loadErr := configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	var target targetStruct
+
+	decodeErr := configSet.Decode(&target)
+
+	s.Equal(targetStruct{
+		ServerHost: "localhost",
+		APIKey:     "testKey2",
+		Settings: settingsStruct{
+			ReadOnlyMode:   true,
+			MaxConnections: 10,
+		},
+		Ignored: "",
+	}, target)
+	s.NoError(decodeErr)
+}
+
+func (s *NamingTestSuite) Test_Decode_WithNamingStrategy_TagOptions() {
+	type targetStruct struct {
+		DBHost string `cfg:",required"`
+		DBPort int    `cfg:",default=5432"`
+	}
+
+	configSet := confiq.New(
+		confiq.WithNamingStrategy(confiq.ScreamingSnakeCase),
+	)
+
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"DB_HOST": "localhost"}})
+
+	loadErr := configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	var target targetStruct
+
+	decodeErr := configSet.Decode(&target)
+
+	s.Equal(targetStruct{
+		DBHost: "localhost",
+		DBPort: 5432,
+	}, target)
+	s.NoError(decodeErr)
+}
+
+func (s *NamingTestSuite) Test_Decode_WithCustomNamingStrategy() {
+	type targetStruct struct {
+		ServerHost string
+	}
+
+	configSet := confiq.New(
+		confiq.WithNamingStrategy(func(fieldName string) string {
+			return "app." + strings.ToLower(fieldName)
+		}),
+	)
+
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"app": map[string]any{"serverhost": "localhost"}}})
+
+	loadErr := configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	var target targetStruct
+
+	decodeErr := configSet.Decode(&target)
+
+	s.Equal("localhost", target.ServerHost)
+	s.NoError(decodeErr)
+}
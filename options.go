@@ -1,5 +1,11 @@
 package confiq
 
+import (
+	"reflect"
+	"strings"
+	"time"
+)
+
 // ConfigSetOptions is exposed so that functions which wrap the New function can make adding the WithTag option easier.
 type ConfigSetOptions []loadOption
 
@@ -12,6 +18,119 @@ func WithTag(tag string) configSetOption {
 	}
 }
 
+// WithNamingStrategy sets the naming strategy used to derive the config paths of struct fields which don't have a path in their struct tag.
+func WithNamingStrategy(namingStrategy NamingStrategy) configSetOption {
+	return func(s *ConfigSet) {
+		s.decoder.namingStrategy = namingStrategy
+	}
+}
+
+// WithPolymorphicType registers the implementations of the interface type T for decoding fields of type T.
+// The implementation is selected by the value at the discriminator key, e.g. "type", among the keys of the implementations map.
+func WithPolymorphicType[T any](discriminatorKey string, implementations map[string]T) configSetOption {
+	return func(s *ConfigSet) {
+		implementationTypes := make(map[string]reflect.Type, len(implementations))
+
+		for discriminatorValue, implementation := range implementations {
+			if implementationType := reflect.TypeOf(implementation); implementationType != nil {
+				implementationTypes[discriminatorValue] = implementationType
+			}
+		}
+
+		s.decoder.polymorphicTypes[reflect.TypeFor[T]()] = polymorphicType{
+			discriminatorKey: discriminatorKey,
+			implementations:  implementationTypes,
+		}
+	}
+}
+
+// WithSliceSeparator sets the separator at which strings are split when they are decoded into slices, arrays or maps.
+func WithSliceSeparator(separator string) configSetOption {
+	return func(s *ConfigSet) {
+		s.decoder.sliceSeparator = separator
+	}
+}
+
+// WithKeyValueSeparator sets the separator between the keys and values of the key-value pairs in strings which are decoded into maps.
+// By default the pairs are split at their first colon or equals sign.
+func WithKeyValueSeparator(separator string) configSetOption {
+	return func(s *ConfigSet) {
+		s.decoder.keyValueSeparator = separator
+	}
+}
+
+// WithTimeLayouts sets the layouts which are attempted in order when decoding strings into time.Time fields.
+// The layout of individual fields can be set with the layout= tag option.
+func WithTimeLayouts(layouts ...string) configSetOption {
+	return func(s *ConfigSet) {
+		s.decoder.timeLayouts = layouts
+	}
+}
+
+// WithDefaultLocation sets the location of the times decoded from strings without time zone information and from unix timestamps.
+// By default these times are in UTC.
+func WithDefaultLocation(location *time.Location) configSetOption {
+	return func(s *ConfigSet) {
+		if location != nil {
+			s.decoder.location = location
+		}
+	}
+}
+
+// WithDefaultDurationUnit sets the unit of the bare numbers decoded into time.Duration fields, which are nanoseconds by default.
+// The unit of individual fields can be set with the unit= tag option, e.g. unit=s.
+func WithDefaultDurationUnit(unit time.Duration) configSetOption {
+	return func(s *ConfigSet) {
+		if unit > 0 {
+			s.decoder.durationUnit = unit
+		}
+	}
+}
+
+// WithFileLoader sets the loader of the files with the given extension, e.g. ".ini", which are loaded by the LoadGlob and LoadDirectory methods.
+func WithFileLoader(extension string, fileLoader FileLoader) configSetOption {
+	return func(s *ConfigSet) {
+		extension = strings.ToLower(extension)
+		if !strings.HasPrefix(extension, ".") {
+			extension = "." + extension
+		}
+
+		s.decoder.fileLoaders[extension] = fileLoader
+	}
+}
+
+// WithFileLoaders sets the loaders of the files with the extensions of the map keys, such as the ones of the confiqfiles package.
+func WithFileLoaders(fileLoaders map[string]FileLoader) configSetOption {
+	return func(s *ConfigSet) {
+		for extension, fileLoader := range fileLoaders {
+			WithFileLoader(extension, fileLoader)(s)
+		}
+	}
+}
+
+// WithBoolValues sets the strings which are decoded into true and false values in bool fields, replacing the default ones.
+// The strings are matched case-insensitively.
+func WithBoolValues(trueValues, falseValues []string) configSetOption {
+	return func(s *ConfigSet) {
+		s.decoder.boolValues = make(map[string]bool, len(trueValues)+len(falseValues))
+
+		for _, trueValue := range trueValues {
+			s.decoder.boolValues[strings.ToLower(trueValue)] = true
+		}
+
+		for _, falseValue := range falseValues {
+			s.decoder.boolValues[strings.ToLower(falseValue)] = false
+		}
+	}
+}
+
+// WithStrictBools makes bool fields only accept bool values, such as the ones of JSON, TOML or YAML, instead of parsing strings and numbers.
+func WithStrictBools() configSetOption {
+	return func(s *ConfigSet) {
+		s.decoder.strictBools = true
+	}
+}
+
 // LoadOptions is exposed so that functions which wrap the Load function can make adding the WithPrefix option easier.
 type LoadOptions []loadOption
 
@@ -42,3 +161,17 @@ func FromPrefix(prefix string) decodeOption {
 		d.prefix = prefix
 	}
 }
+
+// DisallowUnknownKeys makes the decoding fail if the config contains values which were not used by any of the target fields.
+func DisallowUnknownKeys() decodeOption {
+	return func(d *decodeSettings) {
+		d.disallowUnknownKeys = true
+	}
+}
+
+// ReportUnusedKeys sets a handler which is called with the paths of the config values which were not used by any of the target fields.
+func ReportUnusedKeys(handler func(unusedKeys []string)) decodeOption {
+	return func(d *decodeSettings) {
+		d.unusedKeysHandler = handler
+	}
+}
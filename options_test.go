@@ -104,3 +104,159 @@ func (s *OptionsTestSuite) Test_FromPrefix() {
 	decodeErr := configSet.Decode(&cfg, confiq.FromPrefix("test_struct"))
 	s.NoError(decodeErr)
 }
+
+func (s *OptionsTestSuite) Test_DisallowUnknownKeys() {
+	type ConfigStruct struct {
+		TestString string `cfg:"test_string"`
+		TestInt    int    `cfg:"test_section.test_int"`
+	}
+
+	configSet := confiq.New()
+
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_string": "test",
+		"test_section": map[string]any{
+			"test_int":  1,
+			"test_tpyo": 2,
+		},
+	}})
+
+	loadErr := configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	var cfg ConfigStruct
+
+	decodeErr := configSet.Decode(&cfg, confiq.DisallowUnknownKeys())
+
+	s.ErrorIs(decodeErr, confiq.ErrUnknownKeys)
+	s.ErrorContains(decodeErr, "test_section.test_tpyo")
+}
+
+func (s *OptionsTestSuite) Test_DisallowUnknownKeys_WithUntaggedFields() {
+	type SectionStruct struct {
+		TestInt   int `cfg:"test_int"`
+		TestExtra map[string]any
+	}
+
+	type ConfigStruct struct {
+		TestString  string        `cfg:"test_string"`
+		TestSection SectionStruct `cfg:"test_section"`
+		TestExtra   string
+	}
+
+	configSet := confiq.New()
+
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_string":   "test",
+		"max_conection": 5,
+		"test_section": map[string]any{
+			"test_int":  1,
+			"test_tpyo": 2,
+		},
+	}})
+
+	loadErr := configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	var cfg ConfigStruct
+
+	decodeErr := configSet.Decode(&cfg, confiq.DisallowUnknownKeys())
+
+	s.ErrorIs(decodeErr, confiq.ErrUnknownKeys)
+	s.ErrorContains(decodeErr, "max_conection")
+	s.ErrorContains(decodeErr, "test_section.test_tpyo")
+}
+
+func (s *OptionsTestSuite) Test_DisallowUnknownKeys_AllUsed() {
+	type ServerStruct struct {
+		Host string `cfg:"host"`
+		Port int    `cfg:"port"`
+	}
+
+	type ConfigStruct struct {
+		TestStrings []string       `cfg:"test_strings"`
+		TestServers []ServerStruct `cfg:"test_servers"`
+		TestMap     map[string]int `cfg:"test_map"`
+		TestRaw     []byte         `cfg:"test_raw"`
+	}
+
+	configSet := confiq.New()
+
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_strings": "aleph;beth;gimel",
+		"test_servers": []any{
+			map[string]any{"host": "localhost", "port": 80},
+		},
+		"test_map": map[string]any{"a": 1, "b": 2},
+		"test_raw": []any{1, 2, 3},
+	}})
+
+	loadErr := configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	var cfg ConfigStruct
+
+	decodeErr := configSet.Decode(&cfg, confiq.DisallowUnknownKeys())
+
+	s.NoError(decodeErr)
+}
+
+func (s *OptionsTestSuite) Test_DisallowUnknownKeys_FromPrefix() {
+	type ServerStruct struct {
+		Host string `cfg:"host"`
+	}
+
+	configSet := confiq.New()
+
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_servers": []any{
+			map[string]any{"host": "localhost", "prot": 80},
+		},
+		"test_other": "test",
+	}})
+
+	loadErr := configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	var cfg ServerStruct
+
+	decodeErr := configSet.Decode(&cfg, confiq.FromPrefix("test_servers[0]"), confiq.DisallowUnknownKeys())
+
+	s.ErrorIs(decodeErr, confiq.ErrUnknownKeys)
+	s.ErrorContains(decodeErr, "test_servers[0].prot")
+	s.NotContains(decodeErr.Error(), "test_other")
+}
+
+func (s *OptionsTestSuite) Test_ReportUnusedKeys() {
+	type ConfigStruct struct {
+		TestString string `cfg:"test_string"`
+	}
+
+	configSet := confiq.New()
+
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_string":      "test",
+		"test_bool":        true,
+		"test_string_list": []any{"aleph", "beth"},
+	}})
+
+	loadErr := configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	var (
+		cfg        ConfigStruct
+		unusedKeys []string
+	)
+
+	decodeErr := configSet.Decode(&cfg, confiq.ReportUnusedKeys(func(keys []string) {
+		unusedKeys = keys
+	}))
+
+	s.NoError(decodeErr)
+	s.Equal([]string{"test_bool", "test_string_list[0]", "test_string_list[1]"}, unusedKeys)
+}
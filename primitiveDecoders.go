@@ -1,104 +1,281 @@
 package confiq
 
 import (
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"strconv"
+	"strings"
+)
+
+// defaultBoolValues are the strings which are decoded into bools by default.
+var defaultBoolValues = map[string]bool{
+	"true":     true,
+	"t":        true,
+	"1":        true,
+	"yes":      true,
+	"y":        true,
+	"on":       true,
+	"enable":   true,
+	"enabled":  true,
+	"false":    false,
+	"f":        false,
+	"0":        false,
+	"no":       false,
+	"n":        false,
+	"off":      false,
+	"disable":  false,
+	"disabled": false,
+}
+
+const (
+	rawEncoding    = "raw"
+	base64Encoding = "base64"
+	hexEncoding    = "hex"
 )
 
 var (
-	errCannotParseBool  = errors.New("cannot parse bool")
-	errCannotParseFloat = errors.New("cannot parse float")
-	errCannotParseInt   = errors.New("cannot parse int")
-	errCannotParseUint  = errors.New("cannot parse uint")
+	// ErrNumericOverflow is returned by strict fields if the config value doesn't fit in the numeric type of the field.
+	ErrNumericOverflow = errors.New("numeric value overflows the target type")
+	// ErrNotAnInteger is returned by strict fields if a config value with a fractional part is decoded into an integer field.
+	ErrNotAnInteger = errors.New("numeric value is not an integer")
+	// ErrNegativeUnsigned is returned by strict fields if a negative config value is decoded into an unsigned integer field.
+	ErrNegativeUnsigned = errors.New("negative value cannot be decoded into an unsigned integer")
 )
 
-func decodeString(targetValue reflect.Value, sourceValue any) error {
+var (
+	errCannotParseBool          = errors.New("cannot parse bool")
+	errCannotParseNonBoolValue  = errors.New("cannot parse bool from non-bool type")
+	errCannotParseComplex       = errors.New("cannot parse complex")
+	errCannotDecodeBytes        = errors.New("cannot decode bytes")
+	errUnsupportedBytesEncoding = errors.New("unsupported bytes encoding")
+	errCannotParseFloat         = errors.New("cannot parse float")
+	errCannotParseInt           = errors.New("cannot parse int")
+	errCannotParseUint          = errors.New("cannot parse uint")
+)
+
+func decodeString(targetValue reflect.Value, sourceValue any, _ fieldOptions) error {
 	targetValue.SetString(castToString(sourceValue))
 
 	return nil
 }
 
-func decodeBool(targetValue reflect.Value, sourceValue any) error {
+func (d *decoder) decodeBool(targetValue reflect.Value, sourceValue any, _ fieldOptions) error {
 	if boolValue, ok := sourceValue.(bool); ok {
 		targetValue.SetBool(boolValue)
 
 		return nil
-	} else {
-		parsedBool, err := strconv.ParseBool(castToString(sourceValue))
-		if err != nil {
-			return fmt.Errorf("%w: %w", errCannotParseBool, err)
-		}
+	}
+
+	if d.strictBools {
+		return fmt.Errorf("%w: %T", errCannotParseNonBoolValue, sourceValue)
+	}
 
-		targetValue.SetBool(parsedBool)
+	boolValue, ok := d.boolValues[strings.ToLower(strings.TrimSpace(castToString(sourceValue)))]
+	if !ok {
+		return fmt.Errorf("%w: %v", errCannotParseBool, sourceValue)
 	}
 
+	targetValue.SetBool(boolValue)
+
 	return nil
 }
 
-func decodeFloat(targetValue reflect.Value, sourceValue any) error {
+func decodeFloat(targetValue reflect.Value, sourceValue any, _ fieldOptions) error {
+	var floatValue float64
+
 	switch sV := sourceValue.(type) {
 	case float32:
-		targetValue.SetFloat(float64(sV))
+		floatValue = float64(sV)
 	case float64:
-		targetValue.SetFloat(sV)
+		floatValue = sV
+	case int, int8, int16, int32, int64:
+		floatValue = float64(reflect.ValueOf(sV).Int())
+	case uint, uint8, uint16, uint32, uint64:
+		floatValue = float64(reflect.ValueOf(sV).Uint())
 	default:
-		parsedFloat, err := strconv.ParseFloat(castToString(sourceValue), targetValue.Type().Bits())
+		parsedFloat, err := strconv.ParseFloat(castToString(sourceValue), 64)
 		if err != nil {
+			if errors.Is(err, strconv.ErrRange) {
+				return fmt.Errorf("%w: %v into %s", ErrNumericOverflow, sourceValue, targetValue.Type())
+			}
+
 			return fmt.Errorf("%w: %w", errCannotParseFloat, err)
 		}
 
-		targetValue.SetFloat(parsedFloat)
+		floatValue = parsedFloat
+	}
+
+	if !math.IsInf(floatValue, 0) && targetValue.OverflowFloat(floatValue) {
+		return fmt.Errorf("%w: %v into %s", ErrNumericOverflow, sourceValue, targetValue.Type())
 	}
 
+	targetValue.SetFloat(floatValue)
+
 	return nil
 }
 
-func decodeInt(targetValue reflect.Value, sourceValue any) error {
+func decodeComplex(targetValue reflect.Value, sourceValue any, _ fieldOptions) error {
 	switch sV := sourceValue.(type) {
-	case int:
-		targetValue.SetInt(int64(sV))
-	case int8:
-		targetValue.SetInt(int64(sV))
-	case int16:
-		targetValue.SetInt(int64(sV))
-	case int32:
-		targetValue.SetInt(int64(sV))
-	case int64:
-		targetValue.SetInt(sV)
+	case complex64:
+		targetValue.SetComplex(complex128(sV))
+	case complex128:
+		targetValue.SetComplex(sV)
 	default:
-		parsedInt, err := strconv.ParseInt(castToString(sourceValue), 0, targetValue.Type().Bits())
+		parsedComplex, err := strconv.ParseComplex(castToString(sourceValue), targetValue.Type().Bits())
 		if err != nil {
+			return fmt.Errorf("%w: %w", errCannotParseComplex, err)
+		}
+
+		targetValue.SetComplex(parsedComplex)
+	}
+
+	return nil
+}
+
+func decodeInt(targetValue reflect.Value, sourceValue any, fieldOpts fieldOptions) error {
+	var intValue int64
+
+	switch sV := sourceValue.(type) {
+	case int, int8, int16, int32, int64:
+		intValue = reflect.ValueOf(sV).Int()
+	case uint, uint8, uint16, uint32, uint64:
+		uintValue := reflect.ValueOf(sV).Uint()
+		if uintValue > math.MaxInt64 {
+			return fmt.Errorf("%w: %v into %s", ErrNumericOverflow, sourceValue, targetValue.Type())
+		}
+
+		intValue = int64(uintValue)
+	case float32, float64:
+		floatValue, err := floatToInteger(reflect.ValueOf(sV).Float(), math.MinInt64, math.MaxInt64)
+		if err != nil {
+			return fmt.Errorf("%w: %v into %s", err, sourceValue, targetValue.Type())
+		}
+
+		intValue = int64(floatValue)
+	default:
+		parsedInt, err := strconv.ParseInt(castToString(sourceValue), 0, 64)
+		if err != nil {
+			if errors.Is(err, strconv.ErrRange) {
+				return fmt.Errorf("%w: %v into %s", ErrNumericOverflow, sourceValue, targetValue.Type())
+			}
+
+			// numbers such as "3.0" are accepted as long as they are integers
+			if parsedFloat, floatErr := strconv.ParseFloat(castToString(sourceValue), 64); floatErr == nil {
+				return decodeInt(targetValue, parsedFloat, fieldOpts)
+			}
+
 			return fmt.Errorf("%w: %w", errCannotParseInt, err)
 		}
 
-		targetValue.SetInt(parsedInt)
+		intValue = parsedInt
+	}
+
+	if targetValue.OverflowInt(intValue) {
+		return fmt.Errorf("%w: %v into %s", ErrNumericOverflow, sourceValue, targetValue.Type())
 	}
 
+	targetValue.SetInt(intValue)
+
 	return nil
 }
 
-func decodeUint(targetValue reflect.Value, sourceValue any) error {
+func decodeUint(targetValue reflect.Value, sourceValue any, fieldOpts fieldOptions) error {
+	var uintValue uint64
+
 	switch sV := sourceValue.(type) {
-	case uint:
-		targetValue.SetUint(uint64(sV))
-	case uint8:
-		targetValue.SetUint(uint64(sV))
-	case uint16:
-		targetValue.SetUint(uint64(sV))
-	case uint32:
-		targetValue.SetUint(uint64(sV))
-	case uint64:
-		targetValue.SetUint(sV)
+	case uint, uint8, uint16, uint32, uint64:
+		uintValue = reflect.ValueOf(sV).Uint()
+	case int, int8, int16, int32, int64:
+		intValue := reflect.ValueOf(sV).Int()
+		if intValue < 0 {
+			return fmt.Errorf("%w: %v into %s", ErrNegativeUnsigned, sourceValue, targetValue.Type())
+		}
+
+		uintValue = uint64(intValue)
+	case float32, float64:
+		floatValue := reflect.ValueOf(sV).Float()
+		if floatValue < 0 {
+			return fmt.Errorf("%w: %v into %s", ErrNegativeUnsigned, sourceValue, targetValue.Type())
+		}
+
+		floatValue, err := floatToInteger(floatValue, 0, math.MaxUint64)
+		if err != nil {
+			return fmt.Errorf("%w: %v into %s", err, sourceValue, targetValue.Type())
+		}
+
+		uintValue = uint64(floatValue)
 	default:
-		parsedUint, err := strconv.ParseUint(castToString(sourceValue), 0, targetValue.Type().Bits())
+		stringValue := strings.TrimSpace(castToString(sourceValue))
+		if strings.HasPrefix(stringValue, "-") {
+			return fmt.Errorf("%w: %v into %s", ErrNegativeUnsigned, sourceValue, targetValue.Type())
+		}
+
+		parsedUint, err := strconv.ParseUint(stringValue, 0, 64)
 		if err != nil {
+			if errors.Is(err, strconv.ErrRange) {
+				return fmt.Errorf("%w: %v into %s", ErrNumericOverflow, sourceValue, targetValue.Type())
+			}
+
+			// numbers such as "3.0" are accepted as long as they are integers
+			if parsedFloat, floatErr := strconv.ParseFloat(stringValue, 64); floatErr == nil {
+				return decodeUint(targetValue, parsedFloat, fieldOpts)
+			}
+
 			return fmt.Errorf("%w: %w", errCannotParseUint, err)
 		}
 
-		targetValue.SetUint(parsedUint)
+		uintValue = parsedUint
+	}
+
+	if targetValue.OverflowUint(uintValue) {
+		return fmt.Errorf("%w: %v into %s", ErrNumericOverflow, sourceValue, targetValue.Type())
 	}
 
+	targetValue.SetUint(uintValue)
+
 	return nil
 }
+
+// floatToInteger checks that the float is an integer within the given bounds, so that it can be converted without losing precision.
+func floatToInteger(value, minValue, maxValue float64) (float64, error) {
+	if math.IsNaN(value) || math.IsInf(value, 0) || value != math.Trunc(value) {
+		return 0, ErrNotAnInteger
+	}
+
+	// the upper bounds of int64 and uint64 are rounded up to a power of two as floats, so they are exclusive
+	if value < minValue || value >= maxValue {
+		return 0, ErrNumericOverflow
+	}
+
+	return value, nil
+}
+
+// decodeBytes decodes the string in the given encoding, which is raw if it isn't set.
+func decodeBytes(value, encoding string) ([]byte, error) {
+	switch strings.ToLower(encoding) {
+	case "", rawEncoding:
+		return []byte(value), nil
+	case base64Encoding:
+		// both the standard and the URL-safe alphabets are accepted, with or without padding
+		for _, alphabet := range []*base64.Encoding{base64.StdEncoding, base64.RawStdEncoding, base64.URLEncoding, base64.RawURLEncoding} {
+			if decodedBytes, err := alphabet.DecodeString(value); err == nil {
+				return decodedBytes, nil
+			}
+		}
+
+		return nil, fmt.Errorf("%w: invalid base64 string", errCannotDecodeBytes)
+	case hexEncoding:
+		decodedBytes, err := hex.DecodeString(value)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errCannotDecodeBytes, err)
+		}
+
+		return decodedBytes, nil
+	default:
+		return nil, fmt.Errorf("%w: %s", errUnsupportedBytesEncoding, encoding)
+	}
+}
@@ -1,6 +1,7 @@
 package confiq_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/greencoda/confiq"
@@ -156,6 +157,105 @@ func (s *PrimitiveDecodersTestSuite) Test_DecodeBool_FromInvalidFormat() {
 	s.Error(decodeErr)
 }
 
+func (s *PrimitiveDecodersTestSuite) Test_DecodeBool_FromVocabulary() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_yes":      "yes",
+		"test_no":       "No",
+		"test_on":       "ON",
+		"test_disabled": " disabled ",
+		"test_number":   1,
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestYes      bool `cfg:"test_yes"`
+		TestNo       bool `cfg:"test_no"`
+		TestOn       bool `cfg:"test_on"`
+		TestDisabled bool `cfg:"test_disabled"`
+		TestNumber   bool `cfg:"test_number"`
+	}
+
+	var (
+		target   = targetStruct{TestYes: false, TestNo: true, TestOn: false, TestDisabled: true, TestNumber: false}
+		expected = targetStruct{TestYes: true, TestNo: false, TestOn: true, TestDisabled: false, TestNumber: true}
+	)
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(expected, target)
+	s.NoError(decodeErr)
+}
+
+func (s *PrimitiveDecodersTestSuite) Test_DecodeBool_WithBoolValues() {
+	configSet := confiq.New(
+		confiq.WithBoolValues([]string{"Ja"}, []string{"Nein"}),
+	)
+
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_ja":   "ja",
+		"test_nein": "NEIN",
+		"test_yes":  "yes",
+	}})
+
+	loadErr := configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestJa   bool `cfg:"test_ja"`
+		TestNein bool `cfg:"test_nein"`
+	}
+
+	type defaultValueStruct struct {
+		TestYes bool `cfg:"test_yes,strict"`
+	}
+
+	target := targetStruct{TestJa: false, TestNein: true}
+
+	decodeErr := configSet.Decode(&target)
+
+	s.True(target.TestJa)
+	s.False(target.TestNein)
+	s.NoError(decodeErr)
+
+	s.Error(configSet.Decode(&defaultValueStruct{}))
+}
+
+func (s *PrimitiveDecodersTestSuite) Test_DecodeBool_WithStrictBools() {
+	configSet := confiq.New(
+		confiq.WithStrictBools(),
+	)
+
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_bool":   true,
+		"test_string": "true",
+	}})
+
+	loadErr := configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestBool bool `cfg:"test_bool"`
+	}
+
+	type stringStruct struct {
+		TestString bool `cfg:"test_string,strict"`
+	}
+
+	var target targetStruct
+
+	decodeErr := configSet.Decode(&target)
+
+	s.True(target.TestBool)
+	s.NoError(decodeErr)
+
+	s.Error(configSet.Decode(&stringStruct{}))
+}
+
 func (s *PrimitiveDecodersTestSuite) Test_DecodeFloat64() {
 	s.valueContainer.On("Errors").Return([]error{})
 	s.valueContainer.On("Get").Return([]any{map[string]any{"test_float": 0.1234567890123456}})
@@ -495,3 +595,414 @@ func (s *PrimitiveDecodersTestSuite) Test_DecodeUInt_FromInvalidFormat() {
 
 	s.Error(decodeErr)
 }
+
+func (s *PrimitiveDecodersTestSuite) Test_DecodeComplex() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_complex_string": "1.5+2i",
+		"test_complex_number": 3,
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestComplex64  complex64  `cfg:"test_complex_string"`
+		TestComplex128 complex128 `cfg:"test_complex_number"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(complex64(complex(1.5, 2)), target.TestComplex64)
+	s.Equal(complex(3, 0), target.TestComplex128)
+	s.NoError(decodeErr)
+}
+
+func (s *PrimitiveDecodersTestSuite) Test_DecodeComplex_FromComplex() {
+	type targetStruct struct {
+		TestComplex complex128 `cfg:"test_complex"`
+	}
+
+	var (
+		target   targetStruct
+		expected = complex(-1, 0.5)
+	)
+
+	s.configSet.OverrideValue(map[string]any{"test_complex": expected})
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(expected, target.TestComplex)
+	s.NoError(decodeErr)
+}
+
+func (s *PrimitiveDecodersTestSuite) Test_DecodeComplex_FromInvalidFormat() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_complex_invalid_format": "1+2j"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestComplex complex128 `cfg:"test_complex_invalid_format"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *PrimitiveDecodersTestSuite) Test_DecodeBytes() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_raw":       "s3cr3t",
+		"test_base64":    "c2FsdA==",
+		"test_base64url": "_-8",
+		"test_hex":       "deadbeef",
+		"test_elements":  []any{1, 2, 3},
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestRaw       []byte `cfg:"test_raw,encoding=raw"`
+		TestBase64    []byte `cfg:"test_base64,encoding=base64"`
+		TestBase64URL []byte `cfg:"test_base64url,encoding=base64"`
+		TestHex       []byte `cfg:"test_hex,encoding=hex"`
+		TestElements  []byte `cfg:"test_elements"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal([]byte("s3cr3t"), target.TestRaw)
+	s.Equal([]byte("salt"), target.TestBase64)
+	s.Equal([]byte{0xff, 0xef}, target.TestBase64URL)
+	s.Equal([]byte{0xde, 0xad, 0xbe, 0xef}, target.TestHex)
+	s.Equal([]byte{1, 2, 3}, target.TestElements)
+	s.NoError(decodeErr)
+}
+
+func (s *PrimitiveDecodersTestSuite) Test_DecodeBytes_IntoArray() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_hex":   "deadbeef",
+		"test_short": "ab",
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestHex   [4]byte `cfg:"test_hex,encoding=hex"`
+		TestShort [4]byte `cfg:"test_short,encoding=raw"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal([4]byte{0xde, 0xad, 0xbe, 0xef}, target.TestHex)
+	s.Equal([4]byte{'a', 'b', 0, 0}, target.TestShort)
+	s.NoError(decodeErr)
+}
+
+func (s *PrimitiveDecodersTestSuite) Test_DecodeBytes_WithoutEncoding() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_slice": "1;2;3",
+		"test_array": "1;2;3;4",
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestSlice []byte  `cfg:"test_slice"`
+		TestArray [4]byte `cfg:"test_array"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal([]byte{1, 2, 3}, target.TestSlice)
+	s.Equal([4]byte{1, 2, 3, 4}, target.TestArray)
+	s.NoError(decodeErr)
+}
+
+func (s *PrimitiveDecodersTestSuite) Test_DecodeBytes_InSliceAndMap() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_keys":   "dead;beef",
+		"test_salts":  map[string]any{"alpha": "c2FsdA=="},
+		"test_hashes": []any{"deadbeef"},
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestKeys   [][]byte          `cfg:"test_keys,encoding=hex,strict"`
+		TestSalts  map[string][]byte `cfg:"test_salts,encoding=base64,strict"`
+		TestHashes [1][4]byte        `cfg:"test_hashes,encoding=hex,strict"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal([][]byte{{0xde, 0xad}, {0xbe, 0xef}}, target.TestKeys)
+	s.Equal(map[string][]byte{"alpha": []byte("salt")}, target.TestSalts)
+	s.Equal([1][4]byte{{0xde, 0xad, 0xbe, 0xef}}, target.TestHashes)
+	s.NoError(decodeErr)
+}
+
+func (s *PrimitiveDecodersTestSuite) Test_DecodeBytes_IntoArray_WithLengthMismatch() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_hex": "deadbeef"}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestHex [8]byte `cfg:"test_hex,encoding=hex,strict"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Error(decodeErr)
+}
+
+func (s *PrimitiveDecodersTestSuite) Test_DecodeBytes_FromInvalidFormat() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_base64": "not base64!",
+		"test_hex":    "xyz",
+		"test_rot13":  "fnyg",
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type base64Struct struct {
+		TestBytes []byte `cfg:"test_base64,encoding=base64,strict"`
+	}
+
+	type hexStruct struct {
+		TestBytes []byte `cfg:"test_hex,encoding=hex,strict"`
+	}
+
+	type unsupportedEncodingStruct struct {
+		TestBytes []byte `cfg:"test_rot13,encoding=rot13,strict"`
+	}
+
+	s.Error(s.configSet.Decode(&base64Struct{}))
+	s.Error(s.configSet.Decode(&hexStruct{}))
+	s.Error(s.configSet.Decode(&unsupportedEncodingStruct{}))
+}
+
+func (s *PrimitiveDecodersTestSuite) Test_DecodeInt_FromIntegralFloat() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_float":        3.0,
+		"test_float_string": "3.0",
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestInt  int  `cfg:"test_float,strict"`
+		TestUint uint `cfg:"test_float_string,strict"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(3, target.TestInt)
+	s.Equal(uint(3), target.TestUint)
+	s.NoError(decodeErr)
+}
+
+func (s *PrimitiveDecodersTestSuite) Test_DecodeInt_FromFractionalFloat() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{"test_float": 3.7}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type intStruct struct {
+		TestInt int `cfg:"test_float,strict"`
+	}
+
+	type uintStruct struct {
+		TestUint uint64 `cfg:"test_float,strict"`
+	}
+
+	s.ErrorIs(s.configSet.Decode(&intStruct{}), confiq.ErrNotAnInteger)
+	s.ErrorIs(s.configSet.Decode(&uintStruct{}), confiq.ErrNotAnInteger)
+}
+
+func (s *PrimitiveDecodersTestSuite) Test_DecodeInt_WithOverflow() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_int":        300,
+		"test_int_string": "-129",
+		"test_float":      1e19,
+		"test_uint":       uint64(math.MaxUint64),
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type intStruct struct {
+		TestInt8 int8 `cfg:"test_int,strict"`
+	}
+
+	type intStringStruct struct {
+		TestInt8 int8 `cfg:"test_int_string,strict"`
+	}
+
+	type floatStruct struct {
+		TestInt64 int64 `cfg:"test_float,strict"`
+	}
+
+	type uintStruct struct {
+		TestInt64 int64 `cfg:"test_uint,strict"`
+	}
+
+	s.ErrorIs(s.configSet.Decode(&intStruct{}), confiq.ErrNumericOverflow)
+	s.ErrorIs(s.configSet.Decode(&intStringStruct{}), confiq.ErrNumericOverflow)
+	s.ErrorIs(s.configSet.Decode(&floatStruct{}), confiq.ErrNumericOverflow)
+	s.ErrorIs(s.configSet.Decode(&uintStruct{}), confiq.ErrNumericOverflow)
+}
+
+func (s *PrimitiveDecodersTestSuite) Test_DecodeInt_WithOverflow_NonStrict() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_int":   300,
+		"test_valid": 100,
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestInt8  int8 `cfg:"test_int"`
+		TestValid int8 `cfg:"test_valid"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Zero(target.TestInt8)
+	s.Equal(int8(100), target.TestValid)
+	s.NoError(decodeErr)
+}
+
+func (s *PrimitiveDecodersTestSuite) Test_DecodeUInt_WithOverflow() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_int":        256,
+		"test_int_string": "70000",
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type intStruct struct {
+		TestUint8 uint8 `cfg:"test_int,strict"`
+	}
+
+	type intStringStruct struct {
+		TestUint16 uint16 `cfg:"test_int_string,strict"`
+	}
+
+	s.ErrorIs(s.configSet.Decode(&intStruct{}), confiq.ErrNumericOverflow)
+	s.ErrorIs(s.configSet.Decode(&intStringStruct{}), confiq.ErrNumericOverflow)
+}
+
+func (s *PrimitiveDecodersTestSuite) Test_DecodeUInt_FromNegative() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_int":        -1,
+		"test_float":      -2.0,
+		"test_int_string": "-3",
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type intStruct struct {
+		TestUint uint `cfg:"test_int,strict"`
+	}
+
+	type floatStruct struct {
+		TestUint uint `cfg:"test_float,strict"`
+	}
+
+	type intStringStruct struct {
+		TestUint uint `cfg:"test_int_string,strict"`
+	}
+
+	s.ErrorIs(s.configSet.Decode(&intStruct{}), confiq.ErrNegativeUnsigned)
+	s.ErrorIs(s.configSet.Decode(&floatStruct{}), confiq.ErrNegativeUnsigned)
+	s.ErrorIs(s.configSet.Decode(&intStringStruct{}), confiq.ErrNegativeUnsigned)
+}
+
+func (s *PrimitiveDecodersTestSuite) Test_DecodeFloat_WithOverflow() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_float":        1e300,
+		"test_float_string": "1e400",
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type floatStruct struct {
+		TestFloat32 float32 `cfg:"test_float,strict"`
+	}
+
+	type floatStringStruct struct {
+		TestFloat64 float64 `cfg:"test_float_string,strict"`
+	}
+
+	s.ErrorIs(s.configSet.Decode(&floatStruct{}), confiq.ErrNumericOverflow)
+	s.ErrorIs(s.configSet.Decode(&floatStringStruct{}), confiq.ErrNumericOverflow)
+}
+
+func (s *PrimitiveDecodersTestSuite) Test_DecodeFloat_FromInt() {
+	s.valueContainer.On("Errors").Return([]error{})
+	s.valueContainer.On("Get").Return([]any{map[string]any{
+		"test_int":  42,
+		"test_uint": uint8(7),
+	}})
+
+	loadErr := s.configSet.Load(s.valueContainer)
+	s.Require().NoError(loadErr)
+
+	type targetStruct struct {
+		TestFloat32 float32 `cfg:"test_int"`
+		TestFloat64 float64 `cfg:"test_uint"`
+	}
+
+	var target targetStruct
+
+	decodeErr := s.configSet.Decode(&target)
+
+	s.Equal(float32(42), target.TestFloat32)
+	s.Equal(float64(7), target.TestFloat64)
+	s.NoError(decodeErr)
+}
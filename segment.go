@@ -71,3 +71,22 @@ func getNextSegment(path string) (segment, string) {
 
 	return keySegment(index), remainingPath
 }
+
+func appendSegment(path string, nextSegment segment) string {
+	if _, ok := nextSegment.(indexSegment); ok || path == "" {
+		return path + nextSegment.String()
+	}
+
+	return path + segmentDividerChar + nextSegment.String()
+}
+
+func joinPath(path, relativePath string) string {
+	var nextSegment segment
+
+	for relativePath != "" {
+		nextSegment, relativePath = getNextSegment(relativePath)
+		path = appendSegment(path, nextSegment)
+	}
+
+	return path
+}
@@ -0,0 +1,50 @@
+package confiq
+
+import (
+	"slices"
+	"strings"
+)
+
+type usageTracker struct {
+	usedPaths   []string
+	structPaths []string
+}
+
+// markUsed records the path of the config set as used, unless a struct is decoded from it.
+// Fields without a path of their own, such as untagged ones, resolve to the path of their parent struct,
+// which would otherwise mark all of the values of the struct as used.
+func (c *ConfigSet) markUsed() {
+	if c.usage == nil || slices.Contains(c.usage.structPaths, c.path) {
+		return
+	}
+
+	c.usage.usedPaths = append(c.usage.usedPaths, c.path)
+}
+
+// markStruct records that a struct is decoded from the path of the config set, whose values are only used by the fields of the struct.
+func (c *ConfigSet) markStruct() {
+	if c.usage == nil {
+		return
+	}
+
+	c.usage.structPaths = append(c.usage.structPaths, c.path)
+}
+
+// isUsed reports whether the value at the given path was used by the decoder,
+// either directly, as part of a parent value which was decoded as a whole,
+// or as a string which was split into multiple values.
+func (u *usageTracker) isUsed(path string) bool {
+	for _, usedPath := range u.usedPaths {
+		if usedPath == path || isParentPath(usedPath, path) || isParentPath(path, usedPath) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isParentPath(parentPath, path string) bool {
+	return parentPath == "" ||
+		strings.HasPrefix(path, parentPath+segmentDividerChar) ||
+		strings.HasPrefix(path, parentPath+openBraceChar)
+}